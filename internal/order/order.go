@@ -0,0 +1,110 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package order provides the deterministic order of the comparable values,
+// which is used to output them stably, such as formatting a set or a map.
+package order
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// Natural returns the compare function by the natural order
+// if the underlying type of T is an ordered type. Or, return nil.
+func Natural[T any]() func(a, b T) int {
+	switch reflect.TypeOf((*T)(nil)).Elem().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(a, b T) int {
+			return cmp.Compare(reflect.ValueOf(a).Int(), reflect.ValueOf(b).Int())
+		}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(a, b T) int {
+			return cmp.Compare(reflect.ValueOf(a).Uint(), reflect.ValueOf(b).Uint())
+		}
+
+	case reflect.Float32, reflect.Float64:
+		return func(a, b T) int {
+			return cmp.Compare(reflect.ValueOf(a).Float(), reflect.ValueOf(b).Float())
+		}
+
+	case reflect.String:
+		return func(a, b T) int {
+			return strings.Compare(reflect.ValueOf(a).String(), reflect.ValueOf(b).String())
+		}
+
+	default:
+		return nil
+	}
+}
+
+// Compare returns the compare function by the natural order if the underlying
+// type of T is an ordered type. Or, compare the values like Sort, which formats
+// them in each call.
+func Compare[T any]() func(a, b T) int {
+	if compare := Natural[T](); compare != nil {
+		return compare
+	}
+
+	return func(a, b T) int {
+		if c := strings.Compare(fmt.Sprint(a), fmt.Sprint(b)); c != 0 {
+			return c
+		}
+		return strings.Compare(fmt.Sprintf("%T %#v", a, a), fmt.Sprintf("%T %#v", b, b))
+	}
+}
+
+// Sort sorts the values by the natural order if the underlying type of T
+// is an ordered type.
+//
+// Or, sort them by their formatted strings, then by their types and
+// Go-syntax representations for the values formatted to the same string,
+// such as any(1) and "1". It is stable so that the equal values keep
+// their original order.
+func Sort[T any](values []T) {
+	if len(values) < 2 {
+		return
+	}
+
+	if compare := Natural[T](); compare != nil {
+		slices.SortFunc(values, compare)
+		return
+	}
+
+	type pair struct {
+		value T
+		str   string
+		gostr string
+	}
+
+	pairs := make([]pair, len(values))
+	for i, v := range values {
+		pairs[i] = pair{value: v, str: fmt.Sprint(v), gostr: fmt.Sprintf("%T %#v", v, v)}
+	}
+
+	slices.SortStableFunc(pairs, func(a, b pair) int {
+		if c := strings.Compare(a.str, b.str); c != 0 {
+			return c
+		}
+		return strings.Compare(a.gostr, b.gostr)
+	})
+
+	for i := range pairs {
+		values[i] = pairs[i].value
+	}
+}
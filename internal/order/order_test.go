@@ -0,0 +1,54 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package order
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSort(t *testing.T) {
+	type myint int
+	ints := []myint{3, 10, 2}
+	Sort(ints)
+	if s := fmt.Sprint(ints); s != "[2 3 10]" {
+		t.Errorf("expect the natural order, but got %s", s)
+	}
+
+	for i := 0; i < 10; i++ {
+		values := []any{"1", 2, 1, "a"}
+		if i%2 == 1 {
+			values = []any{"a", 1, 2, "1"}
+		}
+
+		Sort(values)
+		if s := fmt.Sprintf("%#v", values); s != `[]interface {}{1, "1", 2, "a"}` {
+			t.Errorf("unexpected order %s", s)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	compare := Compare[any]()
+	if c := compare(1, "1"); c >= 0 {
+		t.Errorf("expect 1 < \"1\", but got %d", c)
+	}
+	if c := compare("b", "a"); c <= 0 {
+		t.Errorf("expect \"b\" > \"a\", but got %d", c)
+	}
+	if c := Compare[uint]()(1, 2); c >= 0 {
+		t.Errorf("expect 1 < 2, but got %d", c)
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrDuplicateValue is returned when the value has been bound to another key.
+var ErrDuplicateValue = errors.New("duplicate value")
+
+// BiMap is a bidirectional map, which keeps both the keys and the values unique.
+//
+// It is not thread-safe.
+type BiMap[K, V comparable] struct {
+	forward  map[K]V
+	backward map[V]K
+}
+
+// NewBiMap returns a new BiMap with the initialization capacity.
+func NewBiMap[K, V comparable](cap int) *BiMap[K, V] {
+	return &BiMap[K, V]{forward: make(map[K]V, cap), backward: make(map[V]K, cap)}
+}
+
+// BiMapFromSlice converts a slice s to a BiMap by the conversion function.
+//
+// If two elements have the same key, the last one wins like FromSlice.
+// But if two different keys have the same value, return an error
+// wrapping ErrDuplicateValue.
+func BiMapFromSlice[S ~[]E, K, V comparable, E any](s S, convert func(E) (K, V)) (*BiMap[K, V], error) {
+	m := NewBiMap[K, V](len(s))
+	for _, e := range s {
+		if err := m.Put(convert(e)); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// Len returns the number of the key-value pairs.
+func (m *BiMap[K, V]) Len() int {
+	return len(m.forward)
+}
+
+// Put binds the key and the value, which replaces the old value of the key.
+//
+// If the value has been bound to another key, return an error
+// wrapping ErrDuplicateValue and do nothing.
+func (m *BiMap[K, V]) Put(k K, v V) error {
+	if _k, ok := m.backward[v]; ok && _k != k {
+		return fmt.Errorf("mapx.BiMap: value '%v' has been bound to key '%v': %w", v, _k, ErrDuplicateValue)
+	}
+	m.ForcePut(k, v)
+	return nil
+}
+
+// ForcePut is the same as Put, but removes the old key of the value
+// if the value has been bound to another key.
+func (m *BiMap[K, V]) ForcePut(k K, v V) {
+	if _v, ok := m.forward[k]; ok {
+		delete(m.backward, _v)
+	}
+	if _k, ok := m.backward[v]; ok {
+		delete(m.forward, _k)
+	}
+
+	m.forward[k] = v
+	m.backward[v] = k
+}
+
+// GetByKey returns the value by the key.
+func (m *BiMap[K, V]) GetByKey(k K) (v V, ok bool) {
+	v, ok = m.forward[k]
+	return
+}
+
+// GetByValue returns the key by the value.
+func (m *BiMap[K, V]) GetByValue(v V) (k K, ok bool) {
+	k, ok = m.backward[v]
+	return
+}
+
+// ContainsKey reports whether the key exists.
+func (m *BiMap[K, V]) ContainsKey(k K) bool {
+	_, ok := m.forward[k]
+	return ok
+}
+
+// ContainsValue reports whether the value exists.
+func (m *BiMap[K, V]) ContainsValue(v V) bool {
+	_, ok := m.backward[v]
+	return ok
+}
+
+// DeleteByKey removes the key-value pair by the key and returns the removed value.
+func (m *BiMap[K, V]) DeleteByKey(k K) (v V, ok bool) {
+	if v, ok = m.forward[k]; ok {
+		delete(m.forward, k)
+		delete(m.backward, v)
+	}
+	return
+}
+
+// DeleteByValue removes the key-value pair by the value and returns the removed key.
+func (m *BiMap[K, V]) DeleteByValue(v V) (k K, ok bool) {
+	if k, ok = m.backward[v]; ok {
+		delete(m.backward, v)
+		delete(m.forward, k)
+	}
+	return
+}
+
+// Inverse returns the inverse view of the map, which shares the same data,
+// so the modification of one is visible by the other.
+func (m *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	return &BiMap[V, K]{forward: m.backward, backward: m.forward}
+}
+
+// Keys returns all the keys.
+func (m *BiMap[K, V]) Keys() []K {
+	return Keys(m.forward)
+}
+
+// Values returns all the values.
+func (m *BiMap[K, V]) Values() []V {
+	return Keys(m.backward)
+}
+
+// Range travels all the key-value pairs until f returns false.
+func (m *BiMap[K, V]) Range(f func(k K, v V) bool) {
+	for k, v := range m.forward {
+		if !f(k, v) {
+			return
+		}
+	}
+}
+
+// Map returns a copy of the map from the keys to the values.
+func (m *BiMap[K, V]) Map() map[K]V {
+	return cloneMap(m.forward, 0)
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleBiMap() {
+	type User struct {
+		ID   int
+		Name string
+	}
+
+	users := []User{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}}
+	m, _ := BiMapFromSlice(users, func(u User) (int, string) { return u.ID, u.Name })
+
+	fmt.Println(m.GetByKey(1))
+	fmt.Println(m.GetByValue("b"))
+	fmt.Println(m.Put(3, "a"))
+
+	m.ForcePut(3, "a")
+	fmt.Println(m.Inverse().GetByKey("a"))
+	fmt.Println(m.ContainsKey(1))
+
+	// Output:
+	// a true
+	// 2 true
+	// mapx.BiMap: value 'a' has been bound to key '1': duplicate value
+	// 3 true
+	// false
+}
+
+func TestBiMap(t *testing.T) {
+	m := NewBiMap[string, int](0)
+	if err := m.Put("a", 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Put("a", 2); err != nil {
+		t.Fatal(err)
+	}
+	if m.ContainsValue(1) || m.Len() != 1 {
+		t.Errorf("expect to remove the old value of the key")
+	}
+	if err := m.Put("b", 2); !errors.Is(err, ErrDuplicateValue) {
+		t.Errorf("expect ErrDuplicateValue, but got %v", err)
+	}
+
+	inv := m.Inverse()
+	inv.ForcePut(3, "c")
+	if v, ok := m.GetByKey("c"); !ok || v != 3 {
+		t.Errorf("expect the inverse view to share the data")
+	}
+
+	if k, ok := m.DeleteByValue(2); !ok || k != "a" {
+		t.Errorf("expect to delete key '%s', but got '%s'", "a", k)
+	}
+	if v, ok := m.DeleteByKey("c"); !ok || v != 3 {
+		t.Errorf("expect to delete value %d, but got %d", 3, v)
+	}
+	if m.Len() != 0 || inv.Len() != 0 {
+		t.Errorf("expect the empty map, but got %v", m.Map())
+	}
+
+	_, err := BiMapFromSlice([]string{"a", "b"}, func(s string) (string, int) { return s, 1 })
+	if !errors.Is(err, ErrDuplicateValue) {
+		t.Errorf("expect ErrDuplicateValue, but got %v", err)
+	}
+}
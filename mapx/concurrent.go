@@ -0,0 +1,395 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"hash/maphash"
+	"math"
+	"reflect"
+	"sync"
+)
+
+// DefaultShards is the default number of the shards of ConcurrentMap.
+const DefaultShards = 32
+
+type shard[K comparable, V any] struct {
+	lock sync.RWMutex
+	maps map[K]V
+}
+
+// ConcurrentMap is a thread-safe map based on the lock-striped shards,
+// each operation of which is atomic per key.
+type ConcurrentMap[K comparable, V any] struct {
+	hash   func(K) uint64
+	mask   uint64
+	shards []shard[K, V]
+}
+
+// NewConcurrentMap returns a new ConcurrentMap with the number of the shards,
+// which is rounded up to a power of 2. If shards is not positive,
+// use DefaultShards instead.
+func NewConcurrentMap[K comparable, V any](shards int) *ConcurrentMap[K, V] {
+	return NewConcurrentMapFunc[K, V](shards, newHasher[K]())
+}
+
+// NewConcurrentMapFunc is the same as NewConcurrentMap,
+// but uses the custom hash function to select the shard of the key.
+func NewConcurrentMapFunc[K comparable, V any](shards int, hash func(K) uint64) *ConcurrentMap[K, V] {
+	if hash == nil {
+		panic("mapx.NewConcurrentMapFunc: the hash function must not be nil")
+	}
+
+	if shards <= 0 {
+		shards = DefaultShards
+	}
+
+	n := 1
+	for n < shards {
+		n <<= 1
+	}
+
+	m := &ConcurrentMap[K, V]{hash: hash, mask: uint64(n - 1), shards: make([]shard[K, V], n)}
+	for i := range m.shards {
+		m.shards[i].maps = make(map[K]V)
+	}
+	return m
+}
+
+func (m *ConcurrentMap[K, V]) shard(k K) *shard[K, V] {
+	return &m.shards[m.hash(k)&m.mask]
+}
+
+// Len returns the number of the key-value pairs.
+//
+// It is not an atomic snapshot of the whole map.
+func (m *ConcurrentMap[K, V]) Len() (n int) {
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.lock.RLock()
+		n += len(s.maps)
+		s.lock.RUnlock()
+	}
+	return
+}
+
+// Load returns the value of the key.
+func (m *ConcurrentMap[K, V]) Load(k K) (v V, ok bool) {
+	s := m.shard(k)
+	s.lock.RLock()
+	v, ok = s.maps[k]
+	s.lock.RUnlock()
+	return
+}
+
+// Store sets the value of the key.
+func (m *ConcurrentMap[K, V]) Store(k K, v V) {
+	s := m.shard(k)
+	s.lock.Lock()
+	s.maps[k] = v
+	s.lock.Unlock()
+}
+
+// Delete removes the key.
+func (m *ConcurrentMap[K, V]) Delete(k K) {
+	s := m.shard(k)
+	s.lock.Lock()
+	delete(s.maps, k)
+	s.lock.Unlock()
+}
+
+// LoadOrStore returns the existing value of the key if present.
+// Or, stores and returns the given value. loaded is true if the value
+// is loaded, or false if stored.
+func (m *ConcurrentMap[K, V]) LoadOrStore(k K, v V) (actual V, loaded bool) {
+	s := m.shard(k)
+	s.lock.Lock()
+	if actual, loaded = s.maps[k]; !loaded {
+		s.maps[k], actual = v, v
+	}
+	s.lock.Unlock()
+	return
+}
+
+// LoadAndDelete removes the key and returns its previous value if present.
+func (m *ConcurrentMap[K, V]) LoadAndDelete(k K) (v V, loaded bool) {
+	s := m.shard(k)
+	s.lock.Lock()
+	if v, loaded = s.maps[k]; loaded {
+		delete(s.maps, k)
+	}
+	s.lock.Unlock()
+	return
+}
+
+// Swap sets the value of the key and returns the previous value if present.
+func (m *ConcurrentMap[K, V]) Swap(k K, v V) (previous V, loaded bool) {
+	s := m.shard(k)
+	s.lock.Lock()
+	previous, loaded = s.maps[k]
+	s.maps[k] = v
+	s.lock.Unlock()
+	return
+}
+
+// CompareAndSwap swaps the old and new values of the key
+// if the value stored in the map is equal to old.
+//
+// Like sync.Map, it panics if the values are not comparable.
+func (m *ConcurrentMap[K, V]) CompareAndSwap(k K, old, new V) (swapped bool) {
+	s := m.shard(k)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if v, ok := s.maps[k]; ok && any(v) == any(old) {
+		s.maps[k] = new
+		swapped = true
+	}
+	return
+}
+
+// CompareAndDelete deletes the key if its value is equal to old.
+//
+// Like sync.Map, it panics if the values are not comparable.
+func (m *ConcurrentMap[K, V]) CompareAndDelete(k K, old V) (deleted bool) {
+	s := m.shard(k)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if v, ok := s.maps[k]; ok && any(v) == any(old) {
+		delete(s.maps, k)
+		deleted = true
+	}
+	return
+}
+
+// Compute computes the new value of the key by f atomically,
+// which is passed the old value and whether it exists.
+//
+// If f returns keep==false, the key is removed. Or, store the new value.
+// f is called with the shard lock held, so it must not access the map.
+func (m *ConcurrentMap[K, V]) Compute(k K, f func(old V, loaded bool) (new V, keep bool)) (v V, ok bool) {
+	s := m.shard(k)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	old, loaded := s.maps[k]
+	if v, ok = f(old, loaded); ok {
+		s.maps[k] = v
+	} else if loaded {
+		delete(s.maps, k)
+	}
+	return
+}
+
+// ComputeIfAbsent returns the existing value of the key if present.
+// Or, computes the value by f atomically, then stores and returns it.
+//
+// f is called with the shard lock held, so it must not access the map.
+func (m *ConcurrentMap[K, V]) ComputeIfAbsent(k K, f func() V) (v V, loaded bool) {
+	s := m.shard(k)
+
+	s.lock.RLock()
+	v, loaded = s.maps[k]
+	s.lock.RUnlock()
+	if loaded {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if v, loaded = s.maps[k]; !loaded {
+		v = f()
+		s.maps[k] = v
+	}
+	return
+}
+
+// ComputeIfPresent computes the new value of the key by f atomically
+// only if the key exists, and reports whether the key exists after computing.
+//
+// If f returns keep==false, the key is removed. Or, store the new value.
+// f is called with the shard lock held, so it must not access the map.
+func (m *ConcurrentMap[K, V]) ComputeIfPresent(k K, f func(old V) (new V, keep bool)) (v V, ok bool) {
+	s := m.shard(k)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	old, loaded := s.maps[k]
+	if !loaded {
+		return
+	}
+
+	if v, ok = f(old); ok {
+		s.maps[k] = v
+	} else {
+		delete(s.maps, k)
+	}
+	return
+}
+
+// TryAdd adds the key-value pair if the key does not exist.
+// Or, do nothing and return false.
+func (m *ConcurrentMap[K, V]) TryAdd(k K, v V) (ok bool) {
+	_, loaded := m.LoadOrStore(k, v)
+	return !loaded
+}
+
+// Pop removes the element by the key and returns the removed value.
+func (m *ConcurrentMap[K, V]) Pop(k K) (v V, ok bool) {
+	return m.LoadAndDelete(k)
+}
+
+// Clear removes all the key-value pairs.
+func (m *ConcurrentMap[K, V]) Clear() {
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.lock.Lock()
+		clear(s.maps)
+		s.lock.Unlock()
+	}
+}
+
+// Range travels all the key-value pairs until f returns false.
+//
+// Each shard is locked for reading during traveling it,
+// so f must not modify the map.
+func (m *ConcurrentMap[K, V]) Range(f func(k K, v V) bool) {
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.lock.RLock()
+		for k, v := range s.maps {
+			if !f(k, v) {
+				s.lock.RUnlock()
+				return
+			}
+		}
+		s.lock.RUnlock()
+	}
+}
+
+// Keys returns all the keys of the map.
+func (m *ConcurrentMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.Len())
+	m.Range(func(k K, _ V) bool { keys = append(keys, k); return true })
+	return keys
+}
+
+// Values returns all the values of the map.
+func (m *ConcurrentMap[K, V]) Values() []V {
+	values := make([]V, 0, m.Len())
+	m.Range(func(_ K, v V) bool { values = append(values, v); return true })
+	return values
+}
+
+// Map returns a copy of the map as a builtin map.
+func (m *ConcurrentMap[K, V]) Map() map[K]V {
+	maps := make(map[K]V, m.Len())
+	m.Range(func(k K, v V) bool { maps[k] = v; return true })
+	return maps
+}
+
+//////////////////////////////////////////////////////////////////////////////
+
+// newHasher returns a hash function for the comparable type K,
+// which makes sure that the equal keys have the same hash.
+func newHasher[K comparable]() func(K) uint64 {
+	seed := maphash.MakeSeed()
+	return func(k K) uint64 {
+		var h maphash.Hash
+		h.SetSeed(seed)
+
+		switch v := any(k).(type) {
+		case string:
+			h.WriteString(v)
+		case int:
+			writeUint64(&h, uint64(v))
+		case int32:
+			writeUint64(&h, uint64(v))
+		case int64:
+			writeUint64(&h, uint64(v))
+		case uint:
+			writeUint64(&h, uint64(v))
+		case uint32:
+			writeUint64(&h, uint64(v))
+		case uint64:
+			writeUint64(&h, v)
+		default:
+			hashValue(&h, reflect.ValueOf(&k).Elem())
+		}
+
+		return h.Sum64()
+	}
+}
+
+func writeUint64(h *maphash.Hash, v uint64) {
+	var b [8]byte
+	for i := range b {
+		b[i] = byte(v >> (8 * i))
+	}
+	_, _ = h.Write(b[:])
+}
+
+func writeFloat64(h *maphash.Hash, f float64) {
+	if f == 0 { // +0 == -0
+		f = 0
+	}
+	writeUint64(h, math.Float64bits(f))
+}
+
+func hashValue(h *maphash.Hash, v reflect.Value) {
+	switch v.Kind() {
+	case reflect.String:
+		h.WriteString(v.String())
+
+	case reflect.Bool:
+		if v.Bool() {
+			_ = h.WriteByte(1)
+		} else {
+			_ = h.WriteByte(0)
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint64(h, uint64(v.Int()))
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint64(h, v.Uint())
+
+	case reflect.Float32, reflect.Float64:
+		writeFloat64(h, v.Float())
+
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		writeFloat64(h, real(c))
+		writeFloat64(h, imag(c))
+
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		writeUint64(h, uint64(v.Pointer()))
+
+	case reflect.Interface:
+		if !v.IsNil() {
+			hashValue(h, v.Elem())
+		}
+
+	case reflect.Array:
+		for i, n := 0, v.Len(); i < n; i++ {
+			hashValue(h, v.Index(i))
+		}
+
+	case reflect.Struct:
+		for i, n := 0, v.NumField(); i < n; i++ {
+			hashValue(h, v.Field(i))
+		}
+	}
+}
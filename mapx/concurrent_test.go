@@ -0,0 +1,112 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"math"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestConcurrentMap(t *testing.T) {
+	m := NewConcurrentMap[string, int](10)
+	if n := len(m.shards); n != 16 {
+		t.Errorf("expect %d shards, but got %d", 16, n)
+	}
+
+	const goroutines, rounds = 8, 1000
+
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < rounds; j++ {
+				m.Compute("counter", func(old int, _ bool) (int, bool) { return old + 1, true })
+				m.ComputeIfAbsent("once", func() int { return j })
+			}
+		}()
+	}
+	wg.Wait()
+
+	if v, _ := m.Load("counter"); v != goroutines*rounds {
+		t.Errorf("expect %d, but got %d", goroutines*rounds, v)
+	}
+	if m.Len() != 2 {
+		t.Errorf("expect %d keys, but got %d", 2, m.Len())
+	}
+
+	if !m.CompareAndSwap("counter", goroutines*rounds, 1) {
+		t.Errorf("expect to swap the value")
+	}
+	if m.CompareAndSwap("counter", 0, 2) {
+		t.Errorf("unexpect to swap the value")
+	}
+
+	if v, ok := m.ComputeIfPresent("counter", func(old int) (int, bool) { return old * 10, true }); !ok || v != 10 {
+		t.Errorf("expect %d, but got %d", 10, v)
+	}
+	if _, ok := m.ComputeIfPresent("none", func(old int) (int, bool) { return 1, true }); ok || m.Len() != 2 {
+		t.Errorf("unexpect to compute the absent key")
+	}
+	if _, ok := m.Compute("once", func(int, bool) (int, bool) { return 0, false }); ok {
+		t.Errorf("expect to remove the key")
+	}
+
+	if !m.TryAdd("a", 1) || m.TryAdd("a", 2) {
+		t.Errorf("unexpected result of TryAdd")
+	}
+	if v, loaded := m.LoadOrStore("a", 3); !loaded || v != 1 {
+		t.Errorf("expect to load %d, but got %d", 1, v)
+	}
+
+	keys := m.Keys()
+	slices.Sort(keys)
+	if !slices.Equal(keys, []string{"a", "counter"}) {
+		t.Errorf("expect %v, but got %v", []string{"a", "counter"}, keys)
+	}
+
+	if v, ok := m.Pop("a"); !ok || v != 1 {
+		t.Errorf("expect to pop %d, but got %d", 1, v)
+	}
+	if _, ok := m.LoadAndDelete("a"); ok {
+		t.Errorf("unexpect to delete the deleted key")
+	}
+
+	m.Clear()
+	if m.Len() != 0 {
+		t.Errorf("expect the empty map, but got %v", m.Map())
+	}
+}
+
+func TestConcurrentMapHasher(t *testing.T) {
+	type key struct {
+		F float64
+		S string
+		I any
+	}
+
+	hash := newHasher[key]()
+	if hash(key{F: 0, I: 1}) != hash(key{F: math.Copysign(0, -1), I: 1}) {
+		t.Errorf("expect the same hash for +0 and -0")
+	}
+
+	m := NewConcurrentMap[key, int](0)
+	m.Store(key{F: 1.5, S: "a", I: "b"}, 1)
+	if v, ok := m.Load(key{F: 1.5, S: "a", I: "b"}); !ok || v != 1 {
+		t.Errorf("expect %d, but got %d", 1, v)
+	}
+}
@@ -0,0 +1,293 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/xgfone/go-generics/internal/order"
+)
+
+// KeyError is the error occurring when converting the element of the key.
+type KeyError[K comparable] struct {
+	Key K
+	Err error
+}
+
+// Error implements the interface error.
+func (e *KeyError[K]) Error() string {
+	return fmt.Sprintf("mapx: key '%v': %s", e.Key, e.Err)
+}
+
+// Unwrap returns the inner error.
+func (e *KeyError[K]) Unwrap() error { return e.Err }
+
+// IndexError is the error occurring when converting the element of the index.
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+// Error implements the interface error.
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("mapx: index %d: %s", e.Index, e.Err)
+}
+
+// Unwrap returns the inner error.
+func (e *IndexError) Unwrap() error { return e.Err }
+
+// ConvertOption is used to configure the error-returning conversions,
+// such as ConvertErr and FromSliceErr.
+type ConvertOption func(*convertOptions)
+
+type convertOptions struct {
+	joinErrors bool
+}
+
+// JoinErrors returns an option to convert all the elements and join
+// all the errors by errors.Join, instead of stopping at the first error.
+func JoinErrors() ConvertOption {
+	return func(o *convertOptions) { o.joinErrors = true }
+}
+
+func newConvertOptions(opts []ConvertOption) (o convertOptions) {
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return
+}
+
+// errCollector collects the errors of the slice elements in order.
+type errCollector struct {
+	join bool
+	errs []error
+}
+
+func newErrCollector(opts []ConvertOption) errCollector {
+	return errCollector{join: newConvertOptions(opts).joinErrors}
+}
+
+// add adds the error and reports whether to continue.
+func (c *errCollector) add(err error) bool {
+	c.errs = append(c.errs, err)
+	return c.join
+}
+
+func (c *errCollector) err() error {
+	switch len(c.errs) {
+	case 0:
+		return nil
+	case 1:
+		return c.errs[0]
+	default:
+		return errors.Join(c.errs...)
+	}
+}
+
+// keyErrCollector collects the errors of the map keys, which makes
+// the result independent of the random iteration order of the map.
+//
+// Without joining, only the error of the least failed key is kept,
+// and the greater keys are skipped after an error occurs. With joining,
+// all the errors are sorted by the key.
+type keyErrCollector[K comparable] struct {
+	join    bool
+	compare func(a, b K) int
+	errs    []*KeyError[K]
+}
+
+func newKeyErrCollector[K comparable](opts []ConvertOption) keyErrCollector[K] {
+	return keyErrCollector[K]{
+		join:    newConvertOptions(opts).joinErrors,
+		compare: order.Compare[K](),
+	}
+}
+
+// skip reports whether the key does not need to be converted.
+func (c *keyErrCollector[K]) skip(k K) bool {
+	return !c.join && len(c.errs) > 0 && c.compare(k, c.errs[0].Key) > 0
+}
+
+func (c *keyErrCollector[K]) add(k K, err error) {
+	if c.join || len(c.errs) == 0 {
+		c.errs = append(c.errs, &KeyError[K]{Key: k, Err: err})
+	} else {
+		c.errs[0] = &KeyError[K]{Key: k, Err: err}
+	}
+}
+
+func (c *keyErrCollector[K]) err() error {
+	switch len(c.errs) {
+	case 0:
+		return nil
+	case 1:
+		return c.errs[0]
+	}
+
+	slices.SortFunc(c.errs, func(a, b *KeyError[K]) int { return c.compare(a.Key, b.Key) })
+	errs := make([]error, len(c.errs))
+	for i, err := range c.errs {
+		errs[i] = err
+	}
+	return errors.Join(errs...)
+}
+
+//////////////////////////////////////////////////////////////////////////////
+
+// ConvertErr is the same as Convert, but the conversion function may fail.
+//
+// It stops at the first error, which is wrapped by *KeyError[K1].
+// With the option JoinErrors, it converts all the key-value pairs
+// and joins all the errors. If any error occurs, return nil.
+//
+// To be deterministic, the first error is the one of the least failed key
+// as if converting the keys in order, and the joined errors are sorted
+// by the key. The keys are ordered by the natural order if K1 is an ordered
+// type, or by their formatted strings.
+func ConvertErr[M ~map[K1]V1, K1, K2 comparable, V1, V2 any](maps M,
+	convert func(K1, V1) (K2, V2, error), opts ...ConvertOption) (map[K2]V2, error) {
+	if maps == nil {
+		return nil, nil
+	}
+
+	errs := newKeyErrCollector[K1](opts)
+	newmap := make(map[K2]V2, len(maps))
+	for k1, v1 := range maps {
+		if errs.skip(k1) {
+			continue
+		}
+
+		k2, v2, err := convert(k1, v1)
+		if err != nil {
+			errs.add(k1, err)
+			continue
+		}
+		newmap[k2] = v2
+	}
+
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
+	return newmap, nil
+}
+
+// FromSliceErr is the same as FromSlice, but the conversion function may fail.
+//
+// It stops at the first error, which is wrapped by *IndexError.
+// With the option JoinErrors, it converts all the elements
+// and joins all the errors. If any error occurs, return nil.
+func FromSliceErr[S ~[]E, K comparable, V, E any](s S, convert func(E) (K, V, error),
+	opts ...ConvertOption) (map[K]V, error) {
+	errs := newErrCollector(opts)
+	maps := make(map[K]V, len(s))
+	for i, e := range s {
+		k, v, err := convert(e)
+		if err != nil {
+			if !errs.add(&IndexError{Index: i, Err: err}) {
+				break
+			}
+			continue
+		}
+		maps[k] = v
+	}
+
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
+	return maps, nil
+}
+
+// AddSliceErr is the same as AddSlice, but the conversion function may fail.
+//
+// The errors are handled like FromSliceErr. If any error occurs,
+// maps is not modified.
+func AddSliceErr[M ~map[K]V, S ~[]E, K comparable, V, E any](maps M, slices S,
+	convert func(E) (K, V, error), opts ...ConvertOption) error {
+	errs := newErrCollector(opts)
+	entries := make([]Entry[K, V], 0, len(slices))
+	for i, e := range slices {
+		k, v, err := convert(e)
+		if err != nil {
+			if !errs.add(&IndexError{Index: i, Err: err}) {
+				break
+			}
+			continue
+		}
+		entries = append(entries, Entry[K, V]{Key: k, Value: v})
+	}
+
+	if err := errs.err(); err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		maps[e.Key] = e.Value
+	}
+	return nil
+}
+
+// KeysFuncErr is the same as KeysFunc, but the conversion function may fail.
+//
+// The errors are handled like ConvertErr.
+func KeysFuncErr[M ~map[K]V, T any, K comparable, V any](maps M, convert func(K) (T, error),
+	opts ...ConvertOption) ([]T, error) {
+	errs := newKeyErrCollector[K](opts)
+	keys := make([]T, 0, len(maps))
+	for k := range maps {
+		if errs.skip(k) {
+			continue
+		}
+
+		t, err := convert(k)
+		if err != nil {
+			errs.add(k, err)
+			continue
+		}
+		keys = append(keys, t)
+	}
+
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// ValuesFuncErr is the same as ValuesFunc, but the conversion function may fail.
+//
+// The errors are handled like ConvertErr, which report the key of the value.
+func ValuesFuncErr[M ~map[K]V, T any, K comparable, V any](maps M, convert func(V) (T, error),
+	opts ...ConvertOption) ([]T, error) {
+	errs := newKeyErrCollector[K](opts)
+	values := make([]T, 0, len(maps))
+	for k, v := range maps {
+		if errs.skip(k) {
+			continue
+		}
+
+		t, err := convert(v)
+		if err != nil {
+			errs.add(k, err)
+			continue
+		}
+		values = append(values, t)
+	}
+
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
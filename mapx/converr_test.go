@@ -0,0 +1,122 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func ExampleFromSliceErr() {
+	parse := func(s string) (string, int, error) {
+		v, err := strconv.Atoi(s)
+		return s, v, err
+	}
+
+	fmt.Println(FromSliceErr([]string{"1", "2"}, parse))
+	fmt.Println(FromSliceErr([]string{"1", "a", "b"}, parse))
+	fmt.Println(FromSliceErr([]string{"1", "a", "b"}, parse, JoinErrors()))
+
+	// Output:
+	// map[1:1 2:2] <nil>
+	// map[] mapx: index 1: strconv.Atoi: parsing "a": invalid syntax
+	// map[] mapx: index 1: strconv.Atoi: parsing "a": invalid syntax
+	// mapx: index 2: strconv.Atoi: parsing "b": invalid syntax
+}
+
+func TestConvertErr(t *testing.T) {
+	maps := map[string]string{"a": "1", "b": "x", "c": "y"}
+
+	_, err := ConvertErr(maps, func(k, v string) (string, int, error) {
+		i, err := strconv.Atoi(v)
+		return k, i, err
+	}, JoinErrors())
+
+	var kerr *KeyError[string]
+	if !errors.As(err, &kerr) || !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("expect a KeyError, but got %v", err)
+	}
+	if errs := err.(interface{ Unwrap() []error }).Unwrap(); len(errs) != 2 {
+		t.Errorf("expect 2 errors, but got %v", errs)
+	}
+
+	values, err := ValuesFuncErr(map[string]string{"a": "1", "b": "2"}, strconv.Atoi)
+	slices.Sort(values)
+	if err != nil || !slices.Equal(values, []int{1, 2}) {
+		t.Errorf("unexpected values %v: %v", values, err)
+	}
+
+	if _, err = ValuesFuncErr(maps, strconv.Atoi); !errors.As(err, &kerr) || kerr.Key == "a" {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	keys, err := KeysFuncErr(map[string]bool{"1": true, "x": true}, strconv.Atoi)
+	if keys != nil || !errors.As(err, &kerr) || kerr.Key != "x" {
+		t.Errorf("unexpected keys %v: %v", keys, err)
+	}
+
+	dst := map[string]int{}
+	convert := func(s string) (string, int, error) { v, err := strconv.Atoi(s); return s, v, err }
+	if err := AddSliceErr(dst, []string{"1", "x"}, convert); err == nil || len(dst) != 0 {
+		t.Errorf("expect an error and dst not to be modified, but got %v: %v", dst, err)
+	}
+	if err := AddSliceErr(dst, []string{"1", "2"}, convert); err != nil || len(dst) != 2 {
+		t.Errorf("unexpected result %v: %v", dst, err)
+	}
+}
+
+func TestConvertErrDeterministic(t *testing.T) {
+	maps := make(map[int]string, 26)
+	for i := 0; i < 26; i++ {
+		maps[i] = string(rune('a' + i))
+	}
+	maps[3], maps[7] = "1", "2" // The only valid values.
+
+	convert := func(k int, v string) (int, int, error) {
+		i, err := strconv.Atoi(v)
+		return k, i, err
+	}
+
+	var joined string
+	for i := 0; i < 20; i++ {
+		_, err := ConvertErr(maps, convert)
+		if expect := `mapx: key '0': strconv.Atoi: parsing "a": invalid syntax`; err == nil || err.Error() != expect {
+			t.Fatalf("expect the error '%s', but got '%v'", expect, err)
+		}
+
+		_, err = ValuesFuncErr(maps, strconv.Atoi)
+		if expect := `mapx: key '0': strconv.Atoi: parsing "a": invalid syntax`; err == nil || err.Error() != expect {
+			t.Fatalf("expect the error '%s', but got '%v'", expect, err)
+		}
+
+		_, err = KeysFuncErr(map[string]int{"b": 0, "x": 0, "1": 0, "a": 0}, strconv.Atoi, JoinErrors())
+		expect := `mapx: key 'a': strconv.Atoi: parsing "a": invalid syntax
+mapx: key 'b': strconv.Atoi: parsing "b": invalid syntax
+mapx: key 'x': strconv.Atoi: parsing "x": invalid syntax`
+		if err == nil || err.Error() != expect {
+			t.Fatalf("expect the error '%s', but got '%v'", expect, err)
+		}
+
+		_, err = ConvertErr(maps, convert, JoinErrors())
+		if i == 0 {
+			joined = err.Error()
+		} else if err.Error() != joined {
+			t.Fatalf("expect the same joined error, but got '%v'", err)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"cmp"
+
+	"github.com/xgfone/go-generics/internal/order"
+)
+
+// Counter is a map to count the elements like collections.Counter of Python.
+//
+// As a map, it can be encoded and decoded as a JSON object by encoding/json
+// if K is a string, an integer or an encoding.TextMarshaler.
+type Counter[K comparable] map[K]int
+
+// NewCounter returns a new Counter which counts the elements.
+func NewCounter[K comparable](elements ...K) Counter[K] {
+	c := make(Counter[K], len(elements))
+	for _, e := range elements {
+		c[e]++
+	}
+	return c
+}
+
+// Get returns the count of the element, which is 0 if it does not exist.
+func (c Counter[K]) Get(k K) int {
+	return c[k]
+}
+
+// Inc increases the count of the element by 1 and returns the new count.
+func (c Counter[K]) Inc(k K) int {
+	return c.Add(k, 1)
+}
+
+// Add increases the count of the element by n and returns the new count.
+func (c Counter[K]) Add(k K, n int) int {
+	c[k] += n
+	return c[k]
+}
+
+// Sub decreases the count of the element by n and returns the new count,
+// which may be zero or negative.
+func (c Counter[K]) Sub(k K, n int) int {
+	c[k] -= n
+	return c[k]
+}
+
+// Total returns the sum of all the counts.
+func (c Counter[K]) Total() (total int) {
+	for _, n := range c {
+		total += n
+	}
+	return
+}
+
+// Elements returns the elements repeating as many times as their counts
+// in an arbitrary order. The elements with the non-positive count are ignored.
+func (c Counter[K]) Elements() []K {
+	var size int
+	for _, n := range c {
+		if n > 0 {
+			size += n
+		}
+	}
+
+	elements := make([]K, 0, size)
+	for k, n := range c {
+		for ; n > 0; n-- {
+			elements = append(elements, k)
+		}
+	}
+	return elements
+}
+
+// MostCommon returns the n most common elements and their counts
+// from the most common to the least, which uses a heap of size n.
+// If n is negative, return all the elements.
+//
+// The elements with the same count are sorted by the natural order
+// if K is an ordered type, or by their formatted strings.
+func (c Counter[K]) MostCommon(n int) []Entry[K, int] {
+	if n < 0 {
+		n = len(c)
+	}
+
+	compare := order.Compare[K]()
+	return TopNByValueFunc(c, n, func(a, b Entry[K, int]) int {
+		if r := cmp.Compare(b.Value, a.Value); r != 0 {
+			return r
+		}
+		return compare(a.Key, b.Key)
+	})
+}
+
+// Clone returns a copy of the counter.
+func (c Counter[K]) Clone() Counter[K] {
+	return cloneMap(c, 0)
+}
+
+// Update adds the counts of all the others into the counter.
+func (c Counter[K]) Update(others ...Counter[K]) {
+	for _, other := range others {
+		for k, n := range other {
+			c[k] += n
+		}
+	}
+}
+
+// Subtract subtracts the counts of all the others from the counter,
+// which may make the counts zero or negative.
+func (c Counter[K]) Subtract(others ...Counter[K]) {
+	for _, other := range others {
+		for k, n := range other {
+			c[k] -= n
+		}
+	}
+}
+
+// RemoveNonPositive removes the elements with the zero or negative count.
+func (c Counter[K]) RemoveNonPositive() {
+	for k, n := range c {
+		if n <= 0 {
+			delete(c, k)
+		}
+	}
+}
+
+// Plus returns a new counter by adding the counts of the two counters,
+// which only keeps the positive counts.
+func (c Counter[K]) Plus(other Counter[K]) Counter[K] {
+	r := c.Clone()
+	r.Update(other)
+	r.RemoveNonPositive()
+	return r
+}
+
+// Minus returns a new counter by subtracting the counts of other,
+// which only keeps the positive counts.
+func (c Counter[K]) Minus(other Counter[K]) Counter[K] {
+	r := c.Clone()
+	r.Subtract(other)
+	r.RemoveNonPositive()
+	return r
+}
+
+// Union returns a new counter with the maximum of the counts of the two counters,
+// which only keeps the positive counts.
+func (c Counter[K]) Union(other Counter[K]) Counter[K] {
+	r := make(Counter[K], max(len(c), len(other)))
+	for _, m := range []Counter[K]{c, other} {
+		for k, n := range m {
+			if n > 0 && n > r[k] {
+				r[k] = n
+			}
+		}
+	}
+	return r
+}
+
+// Intersection returns a new counter with the minimum of the counts of the two counters,
+// which only keeps the positive counts.
+func (c Counter[K]) Intersection(other Counter[K]) Counter[K] {
+	r := make(Counter[K], min(len(c), len(other)))
+	for k, n := range c {
+		if n = min(n, other[k]); n > 0 {
+			r[k] = n
+		}
+	}
+	return r
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func ExampleCounter() {
+	c := NewCounter("a", "b", "a", "c", "a", "b")
+	c.Inc("d")
+	c.Sub("c", 1)
+
+	fmt.Println(c.MostCommon(2))
+	fmt.Println(c.Total())
+
+	data, _ := json.Marshal(c)
+	fmt.Println(string(data))
+
+	// Output:
+	// [{a 3} {b 2}]
+	// 6
+	// {"a":3,"b":2,"c":0,"d":1}
+}
+
+func TestCounterArithmetic(t *testing.T) {
+	c1 := Counter[string]{"a": 3, "b": 1, "c": -1}
+	c2 := Counter[string]{"a": 1, "b": 2, "d": 1}
+
+	tests := []struct {
+		name   string
+		result Counter[string]
+		expect Counter[string]
+	}{
+		{"Plus", c1.Plus(c2), Counter[string]{"a": 4, "b": 3, "d": 1}},
+		{"Minus", c1.Minus(c2), Counter[string]{"a": 2}},
+		{"Union", c1.Union(c2), Counter[string]{"a": 3, "b": 2, "d": 1}},
+		{"Intersection", c1.Intersection(c2), Counter[string]{"a": 1, "b": 1}},
+	}
+	for _, test := range tests {
+		if !reflect.DeepEqual(test.result, test.expect) {
+			t.Errorf("%s: expect %v, but got %v", test.name, test.expect, test.result)
+		}
+	}
+
+	c := c1.Clone()
+	c.Update(c2, c2)
+	c.Subtract(c2)
+	if expect := (Counter[string]{"a": 4, "b": 3, "c": -1, "d": 1}); !reflect.DeepEqual(c, expect) {
+		t.Errorf("expect %v, but got %v", expect, c)
+	}
+
+	elements := c.Elements()
+	slices.Sort(elements)
+	if expect := []string{"a", "a", "a", "a", "b", "b", "b", "d"}; !slices.Equal(elements, expect) {
+		t.Errorf("expect elements %v, but got %v", expect, elements)
+	}
+
+	if all := c.MostCommon(-1); len(all) != 4 || all[3].Key != "c" {
+		t.Errorf("unexpected most common elements: %v", all)
+	}
+
+	ties := NewCounter("d", "b", "c", "a", "b", "c")
+	for i := 0; i < 10; i++ {
+		if s := fmt.Sprint(ties.MostCommon(3)); s != "[{b 2} {c 2} {a 1}]" {
+			t.Fatalf("unexpected most common elements: %s", s)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// COWMap is a thread-safe copy-on-write map for the read-mostly scenarios,
+// which serves the lock-free reads from an immutable snapshot and applies
+// the writes by cloning the snapshot and swapping it.
+//
+// The zero value is an empty map and ready to use.
+type COWMap[K comparable, V any] struct {
+	lock sync.Mutex
+	maps atomic.Pointer[map[K]V]
+}
+
+// NewCOWMap returns a new COWMap initialized with a copy of the map.
+func NewCOWMap[M ~map[K]V, K comparable, V any](m M) *COWMap[K, V] {
+	c := new(COWMap[K, V])
+	c.swap(cloneMap(m, 0))
+	return c
+}
+
+func cloneMap[M ~map[K]V, K comparable, V any](maps M, extra int) map[K]V {
+	newmap := make(map[K]V, len(maps)+extra)
+	for k, v := range maps {
+		newmap[k] = v
+	}
+	return newmap
+}
+
+func (m *COWMap[K, V]) load() map[K]V {
+	if p := m.maps.Load(); p != nil {
+		return *p
+	}
+	return nil
+}
+
+func (m *COWMap[K, V]) swap(maps map[K]V) {
+	m.maps.Store(&maps)
+}
+
+// update clones the current map, modifies the clone by f,
+// and swaps the clone as the current map.
+func (m *COWMap[K, V]) update(f func(map[K]V)) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	maps := cloneMap(m.load(), 1)
+	f(maps)
+	m.swap(maps)
+}
+
+// Len returns the number of the key-value pairs.
+func (m *COWMap[K, V]) Len() int {
+	return len(m.load())
+}
+
+// Get returns the value of the key without any lock.
+func (m *COWMap[K, V]) Get(k K) (v V, ok bool) {
+	v, ok = m.load()[k]
+	return
+}
+
+// Contains reports whether the key exists without any lock.
+func (m *COWMap[K, V]) Contains(k K) bool {
+	_, ok := m.load()[k]
+	return ok
+}
+
+// Set sets the value of the key by copy-on-write.
+func (m *COWMap[K, V]) Set(k K, v V) {
+	m.update(func(maps map[K]V) { maps[k] = v })
+}
+
+// Delete removes the keys by copy-on-write.
+func (m *COWMap[K, V]) Delete(keys ...K) {
+	if len(keys) == 0 {
+		return
+	}
+
+	m.update(func(maps map[K]V) {
+		for _, k := range keys {
+			delete(maps, k)
+		}
+	})
+}
+
+// Update applies a batch of the writes as a transaction,
+// which passes a clone of the current map to f to be modified
+// and swaps it as the current map after f returns.
+//
+// The readers never see the partial modification of f.
+// f must not access the COWMap, and must not retain m after returning.
+func (m *COWMap[K, V]) Update(f func(m map[K]V)) {
+	m.update(f)
+}
+
+// Reset replaces all the key-value pairs with a copy of the map.
+func (m *COWMap[K, V]) Reset(maps map[K]V) {
+	maps = cloneMap(maps, 0)
+
+	m.lock.Lock()
+	m.swap(maps)
+	m.lock.Unlock()
+}
+
+// Snapshot returns an immutable read view of the current map,
+// which is not affected by the later writes.
+func (m *COWMap[K, V]) Snapshot() COWSnapshot[K, V] {
+	return COWSnapshot[K, V]{maps: m.load()}
+}
+
+// COWSnapshot is an immutable read view of COWMap.
+type COWSnapshot[K comparable, V any] struct {
+	maps map[K]V
+}
+
+// Len returns the number of the key-value pairs.
+func (s COWSnapshot[K, V]) Len() int {
+	return len(s.maps)
+}
+
+// Get returns the value of the key.
+func (s COWSnapshot[K, V]) Get(k K) (v V, ok bool) {
+	v, ok = s.maps[k]
+	return
+}
+
+// Contains reports whether the key exists.
+func (s COWSnapshot[K, V]) Contains(k K) bool {
+	_, ok := s.maps[k]
+	return ok
+}
+
+// Range travels all the key-value pairs until f returns false.
+func (s COWSnapshot[K, V]) Range(f func(k K, v V) bool) {
+	for k, v := range s.maps {
+		if !f(k, v) {
+			return
+		}
+	}
+}
+
+// Keys returns all the keys.
+func (s COWSnapshot[K, V]) Keys() []K {
+	return Keys(s.maps)
+}
+
+// Values returns all the values.
+func (s COWSnapshot[K, V]) Values() []V {
+	return Values(s.maps)
+}
+
+// Map returns a copy of the snapshot as a builtin map.
+func (s COWSnapshot[K, V]) Map() map[K]V {
+	return cloneMap(s.maps, 0)
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestCOWMap(t *testing.T) {
+	var m COWMap[string, int]
+	if m.Len() != 0 || m.Contains("a") {
+		t.Errorf("expect the zero value to be empty")
+	}
+
+	init := map[string]int{"a": 1}
+	m2 := NewCOWMap(init)
+	init["b"] = 2
+	if m2.Contains("b") {
+		t.Errorf("expect the map to be copied")
+	}
+
+	m.Set("a", 1)
+	snapshot := m.Snapshot()
+	m.Update(func(maps map[string]int) {
+		maps["b"] = 2
+		maps["c"] = 3
+		delete(maps, "a")
+	})
+	m.Delete("c")
+
+	if snapshot.Len() != 1 || !snapshot.Contains("a") {
+		t.Errorf("expect the snapshot not to be changed, but got %v", snapshot.Map())
+	}
+	if v, ok := m.Get("b"); !ok || v != 2 || m.Len() != 1 {
+		t.Errorf("unexpected map %v", m.Snapshot().Map())
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				m.Update(func(maps map[string]int) { maps["b"]++ })
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				m.Snapshot().Range(func(string, int) bool { return true })
+			}
+		}()
+	}
+	wg.Wait()
+
+	if v, _ := m.Get("b"); v != 402 {
+		t.Errorf("expect %d, but got %d", 402, v)
+	}
+
+	m.Reset(nil)
+	if m.Len() != 0 {
+		t.Errorf("expect the empty map after reset")
+	}
+}
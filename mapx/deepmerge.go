@@ -0,0 +1,200 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import "reflect"
+
+// SliceMode is the mode how DeepMerge handles the slices.
+type SliceMode int
+
+const (
+	// SliceReplace replaces the old slice with the new one.
+	SliceReplace SliceMode = iota
+
+	// SliceAppend appends the new slice to the old one.
+	SliceAppend
+
+	// SliceUnique appends the elements of the new slice, which are not
+	// in the old one, by reflect.DeepEqual.
+	SliceUnique
+)
+
+// DeepMerge merges the trees of srcs into dst recursively in turn, and returns dst.
+//
+// For the same key, if both values are map[string]any, merge them recursively.
+// If both values are []any, handle them by the slice mode.
+// Or, the later value overrides the earlier one.
+//
+// The maps and slices of srcs are copied deeply before being merged,
+// so the result does not share them with srcs. If dst is nil, a new map is created.
+func DeepMerge(dst map[string]any, mode SliceMode, srcs ...map[string]any) map[string]any {
+	if dst == nil {
+		dst = make(map[string]any)
+	}
+
+	for _, src := range srcs {
+		deepMerge(dst, src, mode)
+	}
+	return dst
+}
+
+func deepMerge(dst, src map[string]any, mode SliceMode) {
+	for k, v := range src {
+		old, ok := dst[k]
+		if !ok {
+			dst[k] = deepCopy(v)
+			continue
+		}
+
+		switch newv := v.(type) {
+		case map[string]any:
+			if oldm, ok := old.(map[string]any); ok {
+				deepMerge(oldm, newv, mode)
+				continue
+			}
+
+		case []any:
+			if olds, ok := old.([]any); ok {
+				dst[k] = mergeSlice(olds, newv, mode)
+				continue
+			}
+		}
+
+		dst[k] = deepCopy(v)
+	}
+}
+
+func mergeSlice(olds, news []any, mode SliceMode) []any {
+	switch mode {
+	case SliceAppend:
+		for _, v := range news {
+			olds = append(olds, deepCopy(v))
+		}
+		return olds
+
+	case SliceUnique:
+		for _, v := range news {
+			if !containsDeepEqual(olds, v) {
+				olds = append(olds, deepCopy(v))
+			}
+		}
+		return olds
+
+	default:
+		return deepCopy(news).([]any)
+	}
+}
+
+func containsDeepEqual(vs []any, v any) bool {
+	for _, e := range vs {
+		if reflect.DeepEqual(e, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// deepCopy copies the JSON value deeply, that's, map[string]any and []any.
+func deepCopy(v any) any {
+	switch _v := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(_v))
+		for k, e := range _v {
+			m[k] = deepCopy(e)
+		}
+		return m
+
+	case []any:
+		s := make([]any, len(_v))
+		for i, e := range _v {
+			s[i] = deepCopy(e)
+		}
+		return s
+
+	default:
+		return v
+	}
+}
+
+//////////////////////////////////////////////////////////////////////////////
+
+// ApplyMergePatch applies the JSON Merge Patch defined by RFC 7386
+// to the target and returns the patched result, both of which are
+// the decoded JSON values, such as map[string]any, []any, string, etc.
+//
+// The target is not modified, and the result does not share
+// the maps and slices with the target and the patch.
+func ApplyMergePatch(target, patch any) any {
+	return applyMergePatch(deepCopy(target), patch)
+}
+
+// applyMergePatch is the same as ApplyMergePatch, but modifies the target in place.
+func applyMergePatch(target, patch any) any {
+	p, ok := patch.(map[string]any)
+	if !ok {
+		return deepCopy(patch)
+	}
+
+	t, ok := target.(map[string]any)
+	if !ok {
+		t = make(map[string]any, len(p))
+	}
+
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+		} else {
+			t[k] = applyMergePatch(t[k], v)
+		}
+	}
+
+	return t
+}
+
+// CreateMergePatch creates a JSON Merge Patch defined by RFC 7386,
+// which transforms the original to the modified by ApplyMergePatch.
+//
+// Notice: because null means deletion in the merge patch, the null values
+// in the objects of the modified cannot be represented.
+func CreateMergePatch(original, modified any) any {
+	o, ok1 := original.(map[string]any)
+	m, ok2 := modified.(map[string]any)
+	if !ok1 || !ok2 {
+		return deepCopy(modified)
+	}
+
+	patch := make(map[string]any)
+	for k := range o {
+		if _, ok := m[k]; !ok {
+			patch[k] = nil
+		}
+	}
+
+	for k, v := range m {
+		old, ok := o[k]
+		switch {
+		case !ok:
+			patch[k] = deepCopy(v)
+
+		case reflect.DeepEqual(old, v):
+			// Unchanged
+
+		default:
+			patch[k] = CreateMergePatch(old, v)
+		}
+	}
+
+	return patch
+}
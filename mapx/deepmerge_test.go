@@ -0,0 +1,104 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func decodeJSON(t *testing.T, s string) any {
+	var v any
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestDeepMerge(t *testing.T) {
+	defaults := decodeJSON(t, `{"db":{"host":"localhost","port":3306},"tags":["a","b"]}`).(map[string]any)
+	file := decodeJSON(t, `{"db":{"port":3307,"opts":{"x":1}},"tags":["b","c"]}`).(map[string]any)
+
+	for _, c := range []struct {
+		mode   SliceMode
+		expect string
+	}{
+		{SliceReplace, `{"db":{"host":"localhost","port":3307,"opts":{"x":1}},"tags":["b","c"]}`},
+		{SliceAppend, `{"db":{"host":"localhost","port":3307,"opts":{"x":1}},"tags":["a","b","b","c"]}`},
+		{SliceUnique, `{"db":{"host":"localhost","port":3307,"opts":{"x":1}},"tags":["a","b","c"]}`},
+	} {
+		result := DeepMerge(nil, c.mode, defaults, file)
+		if expect := decodeJSON(t, c.expect); !reflect.DeepEqual(expect, any(result)) {
+			t.Errorf("mode %d: expect %v, but got %v", c.mode, expect, result)
+		}
+	}
+
+	// The sources are not shared with the result.
+	result := DeepMerge(nil, SliceAppend, defaults)
+	result["db"].(map[string]any)["host"] = "example.com"
+	if host := defaults["db"].(map[string]any)["host"]; host != "localhost" {
+		t.Errorf("expect the source not to be modified, but got %v", host)
+	}
+}
+
+func TestMergePatch(t *testing.T) {
+	// The cases come from the Appendix A of RFC 7386.
+	cases := [][3]string{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"a":"foo"}`, `null`, `null`},
+		{`{"a":"foo"}`, `"bar"`, `"bar"`},
+		{`{"e":null}`, `{"a":1}`, `{"e":null,"a":1}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	}
+
+	for _, c := range cases {
+		target, patch, expect := decodeJSON(t, c[0]), decodeJSON(t, c[1]), decodeJSON(t, c[2])
+		if result := ApplyMergePatch(target, patch); !reflect.DeepEqual(expect, result) {
+			t.Errorf("%s + %s: expect %v, but got %v", c[0], c[1], expect, result)
+		}
+		if orig := decodeJSON(t, c[0]); !reflect.DeepEqual(orig, target) {
+			t.Errorf("expect the target not to be modified, but got %v", target)
+		}
+	}
+
+	original := decodeJSON(t, `{"a":"b","c":{"d":"e","f":"g"},"h":[1]}`)
+	modified := decodeJSON(t, `{"a":"z","c":{"d":"e"},"i":true,"h":[1]}`)
+	patch := CreateMergePatch(original, modified)
+	if expect := decodeJSON(t, `{"a":"z","c":{"f":null},"i":true}`); !reflect.DeepEqual(expect, patch) {
+		t.Errorf("expect %v, but got %v", expect, patch)
+	}
+	if result := ApplyMergePatch(original, patch); !reflect.DeepEqual(modified, result) {
+		t.Errorf("expect %v, but got %v", modified, result)
+	}
+
+	target := decodeJSON(t, `{"a":{"x":1},"b":[{"y":2}]}`)
+	result := ApplyMergePatch(target, map[string]any{"c": 3}).(map[string]any)
+	result["a"].(map[string]any)["x"] = 0
+	result["b"].([]any)[0].(map[string]any)["y"] = 0
+	if orig := decodeJSON(t, `{"a":{"x":1},"b":[{"y":2}]}`); !reflect.DeepEqual(orig, target) {
+		t.Errorf("expect the result not to share the untouched values with the target, but got %v", target)
+	}
+}
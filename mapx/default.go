@@ -0,0 +1,174 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+// GetOrDefault returns the value of the key if it exists. Or, return defaultv.
+func GetOrDefault[M ~map[K]V, K comparable, V any](maps M, k K, defaultv V) V {
+	if v, ok := maps[k]; ok {
+		return v
+	}
+	return defaultv
+}
+
+// GetOrSet returns the value of the key if it exists.
+// Or, set the key to v and return v.
+//
+// loaded reports whether the key has existed.
+func GetOrSet[M ~map[K]V, K comparable, V any](maps M, k K, v V) (value V, loaded bool) {
+	if value, loaded = maps[k]; !loaded {
+		maps[k] = v
+		value = v
+	}
+	return
+}
+
+// GetOrCompute returns the value of the key if it exists.
+// Or, set the key to the result of compute and return it.
+//
+// compute is only called when the key does not exist.
+func GetOrCompute[M ~map[K]V, K comparable, V any](maps M, k K, compute func() V) V {
+	v, ok := maps[k]
+	if !ok {
+		v = compute()
+		maps[k] = v
+	}
+	return v
+}
+
+// Upsert sets the key to the result of update and returns it,
+// which is passed the old value and whether the key has existed.
+func Upsert[M ~map[K]V, K comparable, V any](maps M, k K, update func(old V, exists bool) V) V {
+	old, exists := maps[k]
+	v := update(old, exists)
+	maps[k] = v
+	return v
+}
+
+// Increment adds delta to the value of the key, which is regarded as 0
+// if the key does not exist, and returns the new value.
+func Increment[M ~map[K]N, K comparable, N Number](maps M, k K, delta N) N {
+	v := maps[k] + delta
+	maps[k] = v
+	return v
+}
+
+// DefaultMap is a map to create the value by the factory function
+// automatically when accessing a missing key, like defaultdict of Python.
+//
+// The zero value is an empty map using the zero value of V as the default.
+// But it is not thread-safe.
+type DefaultMap[K comparable, V any] struct {
+	factory func() V
+	maps    map[K]V
+}
+
+// NewDefaultMap returns a new DefaultMap with the factory function,
+// which may be nil to use the zero value of V.
+func NewDefaultMap[K comparable, V any](factory func() V) *DefaultMap[K, V] {
+	return &DefaultMap[K, V]{factory: factory, maps: make(map[K]V)}
+}
+
+func (m *DefaultMap[K, V]) newValue() (v V) {
+	if m.factory != nil {
+		v = m.factory()
+	}
+	return
+}
+
+// Get returns the value of the key.
+//
+// If the key does not exist, create it by the factory function, store and return it.
+func (m *DefaultMap[K, V]) Get(k K) V {
+	v, ok := m.maps[k]
+	if !ok {
+		if m.maps == nil {
+			m.maps = make(map[K]V)
+		}
+		v = m.newValue()
+		m.maps[k] = v
+	}
+	return v
+}
+
+// Lookup returns the value of the key without creating it.
+func (m *DefaultMap[K, V]) Lookup(k K) (v V, ok bool) {
+	v, ok = m.maps[k]
+	return
+}
+
+// Update sets the key to the result of update, which is passed
+// the value returned by Get, and returns the new value.
+//
+// It is useful for the value that cannot be modified in place, such as a slice:
+//
+//	m.Update(k, func(s []int) []int { return append(s, 1) })
+func (m *DefaultMap[K, V]) Update(k K, update func(V) V) V {
+	v := update(m.Get(k))
+	m.maps[k] = v
+	return v
+}
+
+// Set sets the key to the value.
+func (m *DefaultMap[K, V]) Set(k K, v V) {
+	if m.maps == nil {
+		m.maps = make(map[K]V)
+	}
+	m.maps[k] = v
+}
+
+// Contains reports whether the key exists.
+func (m *DefaultMap[K, V]) Contains(k K) bool {
+	_, ok := m.maps[k]
+	return ok
+}
+
+// Delete deletes the key and reports whether it has existed.
+func (m *DefaultMap[K, V]) Delete(k K) (ok bool) {
+	return Delete(m.maps, k)
+}
+
+// Len returns the number of the keys.
+func (m *DefaultMap[K, V]) Len() int {
+	return len(m.maps)
+}
+
+// Clear deletes all the keys.
+func (m *DefaultMap[K, V]) Clear() {
+	clear(m.maps)
+}
+
+// Range calls the function f for each key-value pair until f returns false.
+func (m *DefaultMap[K, V]) Range(f func(k K, v V) bool) {
+	for k, v := range m.maps {
+		if !f(k, v) {
+			return
+		}
+	}
+}
+
+// Keys returns all the keys.
+func (m *DefaultMap[K, V]) Keys() []K {
+	return Keys(m.maps)
+}
+
+// Values returns all the values.
+func (m *DefaultMap[K, V]) Values() []V {
+	return Values(m.maps)
+}
+
+// Map returns a copy of the inner map.
+func (m *DefaultMap[K, V]) Map() map[K]V {
+	return cloneMap(m.maps, 0)
+}
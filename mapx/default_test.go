@@ -0,0 +1,104 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleDefaultMap() {
+	// Build the nested map: group => user => events
+	m := NewDefaultMap[string](func() *DefaultMap[string, []string] {
+		return NewDefaultMap[string, []string](nil)
+	})
+
+	m.Get("g1").Update("u1", func(s []string) []string { return append(s, "login") })
+	m.Get("g1").Update("u1", func(s []string) []string { return append(s, "logout") })
+	m.Get("g2").Update("u2", func(s []string) []string { return append(s, "login") })
+
+	for _, group := range SortedKeys(m.Map()) {
+		users := m.Get(group).Map()
+		for _, user := range SortedKeys(users) {
+			fmt.Println(group, user, users[user])
+		}
+	}
+
+	// Output:
+	// g1 u1 [login logout]
+	// g2 u2 [login]
+}
+
+func TestGetOrHelpers(t *testing.T) {
+	m := map[string]int{"a": 1}
+
+	if v := GetOrDefault(m, "b", 2); v != 2 || len(m) != 1 {
+		t.Errorf("GetOrDefault: unexpected value %d or map %v", v, m)
+	}
+
+	if v, loaded := GetOrSet(m, "a", 3); !loaded || v != 1 {
+		t.Errorf("GetOrSet: expect 1 and loaded, but got %d and %v", v, loaded)
+	}
+	if v, loaded := GetOrSet(m, "b", 3); loaded || v != 3 || m["b"] != 3 {
+		t.Errorf("GetOrSet: expect 3 and not loaded, but got %d and %v", v, loaded)
+	}
+
+	var calls int
+	compute := func() int { calls++; return 4 }
+	if v := GetOrCompute(m, "c", compute); v != 4 || m["c"] != 4 {
+		t.Errorf("GetOrCompute: expect 4, but got %d", v)
+	}
+	if v := GetOrCompute(m, "c", compute); v != 4 || calls != 1 {
+		t.Errorf("GetOrCompute: expect 4 and 1 call, but got %d and %d calls", v, calls)
+	}
+
+	upsert := func(old int, exists bool) int {
+		if exists {
+			return old * 10
+		}
+		return -1
+	}
+	if v := Upsert(m, "a", upsert); v != 10 {
+		t.Errorf("Upsert: expect 10, but got %d", v)
+	}
+	if v := Upsert(m, "d", upsert); v != -1 {
+		t.Errorf("Upsert: expect -1, but got %d", v)
+	}
+
+	f := map[string]float64{}
+	Increment(f, "x", 1.5)
+	if v := Increment(f, "x", 2); v != 3.5 {
+		t.Errorf("Increment: expect 3.5, but got %v", v)
+	}
+}
+
+func TestDefaultMapZero(t *testing.T) {
+	var m DefaultMap[string, int]
+	if v, ok := m.Lookup("a"); ok || v != 0 {
+		t.Errorf("Lookup: unexpected %d and %v", v, ok)
+	}
+	if m.Get("a"); !m.Contains("a") || m.Len() != 1 {
+		t.Errorf("Get does not create the missing key")
+	}
+	if v := m.Update("a", func(v int) int { return v + 2 }); v != 2 {
+		t.Errorf("Update: expect 2, but got %d", v)
+	}
+	if m.Map()["a"] = 3; m.Get("a") != 2 {
+		t.Errorf("expect Map to return a copy")
+	}
+	if !m.Delete("a") || m.Len() != 0 {
+		t.Errorf("Delete: fail to delete the key")
+	}
+}
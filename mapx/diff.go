@@ -0,0 +1,113 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xgfone/go-generics/internal/order"
+)
+
+// Change is the old and new values of a changed key.
+type Change[V any] struct {
+	Old V
+	New V
+}
+
+// MapDiff is the difference between an old map and a new map.
+type MapDiff[K comparable, V any] struct {
+	Added   map[K]V         // The keys only in the new map.
+	Removed map[K]V         // The keys only in the old map.
+	Changed map[K]Change[V] // The keys in both maps but with the different values.
+}
+
+// Diff compares the old and new maps by == and returns the difference.
+func Diff[M ~map[K]V, K, V comparable](old, new M) MapDiff[K, V] {
+	return DiffFunc(old, new, func(a, b V) bool { return a == b })
+}
+
+// DiffFunc is the same as Diff, but compares the values by the equal function.
+func DiffFunc[M ~map[K]V, K comparable, V any](old, new M, equal func(a, b V) bool) MapDiff[K, V] {
+	d := MapDiff[K, V]{
+		Added:   make(map[K]V),
+		Removed: make(map[K]V),
+		Changed: make(map[K]Change[V]),
+	}
+
+	for k, ov := range old {
+		if nv, ok := new[k]; !ok {
+			d.Removed[k] = ov
+		} else if !equal(ov, nv) {
+			d.Changed[k] = Change[V]{Old: ov, New: nv}
+		}
+	}
+
+	for k, nv := range new {
+		if _, ok := old[k]; !ok {
+			d.Added[k] = nv
+		}
+	}
+
+	return d
+}
+
+// Patch applies the difference to the map m, which removes the removed keys,
+// adds the added keys and updates the changed keys with the new values.
+func Patch[M ~map[K]V, K comparable, V any](m M, diff MapDiff[K, V]) {
+	for k := range diff.Removed {
+		delete(m, k)
+	}
+	for k, v := range diff.Added {
+		m[k] = v
+	}
+	for k, c := range diff.Changed {
+		m[k] = c.New
+	}
+}
+
+// IsEmpty reports whether there is no difference.
+func (d MapDiff[K, V]) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// String returns the stable string of the difference sorted by the keys,
+// like "{+a=1 -b=2 ~c=3->4}", which is used for logging.
+func (d MapDiff[K, V]) String() string {
+	keys := make([]K, 0, len(d.Added)+len(d.Removed)+len(d.Changed))
+	keys = append(keys, Keys(d.Added)...)
+	keys = append(keys, Keys(d.Removed)...)
+	keys = append(keys, Keys(d.Changed)...)
+	order.Sort(keys)
+
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+
+		if v, ok := d.Added[k]; ok {
+			fmt.Fprintf(&b, "+%v=%v", k, v)
+		} else if v, ok := d.Removed[k]; ok {
+			fmt.Fprintf(&b, "-%v=%v", k, v)
+		} else {
+			c := d.Changed[k]
+			fmt.Fprintf(&b, "~%v=%v->%v", k, c.Old, c.New)
+		}
+	}
+	b.WriteByte('}')
+	return b.String()
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"testing"
+)
+
+func ExampleDiff() {
+	old := map[string]string{"app": "web", "env": "dev", "team": "a"}
+	new := map[string]string{"app": "web", "env": "prod", "tier": "1"}
+
+	diff := Diff(old, new)
+	fmt.Println(diff)
+
+	Patch(old, diff)
+	fmt.Println(old)
+
+	// Output:
+	// {~env=dev->prod -team=a +tier=1}
+	// map[app:web env:prod tier:1]
+}
+
+func TestDiffFunc(t *testing.T) {
+	old := map[int][]string{1: {"a"}, 2: {"b"}, 10: {"c"}}
+	new := map[int][]string{1: {"a"}, 2: {"B"}, 3: {"d"}}
+
+	diff := DiffFunc(old, new, slices.Equal[[]string])
+	if expect := "{~2=[b]->[B] +3=[d] -10=[c]}"; diff.String() != expect {
+		t.Errorf("expect '%s', but got '%s'", expect, diff.String())
+	}
+
+	Patch(old, diff)
+	if !maps.EqualFunc(old, new, slices.Equal[[]string]) {
+		t.Errorf("expect %v, but got %v", new, old)
+	}
+
+	if d := Diff(map[string]int{"a": 1}, map[string]int{"a": 1}); !d.IsEmpty() {
+		t.Errorf("expect no difference, but got %v", d)
+	}
+
+	for i := 0; i < 10; i++ {
+		d := Diff(map[any]int{}, map[any]int{1: 1, "1": 2, "a": 3})
+		if expect := "{+1=1 +1=2 +a=3}"; d.String() != expect {
+			t.Fatalf("expect '%s', but got '%s'", expect, d.String())
+		}
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/xgfone/go-generics/internal/order"
+)
+
+// Entry is a key-value pair of the map.
+type Entry[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// NewEntry returns a new Entry.
+func NewEntry[K comparable, V any](k K, v V) Entry[K, V] {
+	return Entry[K, V]{Key: k, Value: v}
+}
+
+// Entries returns all the key-value pairs of the map in an arbitrary order.
+func Entries[M ~map[K]V, K comparable, V any](maps M) []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, len(maps))
+	for k, v := range maps {
+		entries = append(entries, Entry[K, V]{Key: k, Value: v})
+	}
+	return entries
+}
+
+// EntriesFunc returns all the key-value pairs of the map by the conversion function.
+func EntriesFunc[M ~map[K]V, T any, K comparable, V any](maps M, convert func(K, V) T) []T {
+	entries := make([]T, 0, len(maps))
+	for k, v := range maps {
+		entries = append(entries, convert(k, v))
+	}
+	return entries
+}
+
+// FromEntries converts the key-value pairs to a map.
+//
+// If the entries have the same key, the last one wins.
+func FromEntries[S ~[]Entry[K, V], K comparable, V any](entries S) map[K]V {
+	maps := make(map[K]V, len(entries))
+	for _, e := range entries {
+		maps[e.Key] = e.Value
+	}
+	return maps
+}
+
+// SortEntriesByKey sorts the entries by the key in the ascending order.
+func SortEntriesByKey[S ~[]Entry[K, V], K cmp.Ordered, V any](entries S) {
+	slices.SortFunc(entries, func(a, b Entry[K, V]) int { return cmp.Compare(a.Key, b.Key) })
+}
+
+// SortEntriesByValue sorts the entries by the value in the ascending order,
+// and the entries with the same value are sorted by the key, which uses
+// the natural order if K is an ordered type, or their formatted strings.
+func SortEntriesByValue[S ~[]Entry[K, V], K comparable, V cmp.Ordered](entries S) {
+	compare := order.Compare[K]()
+	slices.SortFunc(entries, func(a, b Entry[K, V]) int {
+		if c := cmp.Compare(a.Value, b.Value); c != 0 {
+			return c
+		}
+		return compare(a.Key, b.Key)
+	})
+}
+
+// TopNByValue returns at most n entries with the greatest values
+// in the descending order, which are compared by the less function.
+//
+// It uses a heap of size n, so it is more efficient than sorting
+// all the entries when n is much less than the size of the map.
+//
+// Notice: the entries with the equal values are in the random iteration
+// order of the map. Use TopNByValueFunc to break the ties, such as by key.
+func TopNByValue[M ~map[K]V, K comparable, V any](maps M, n int, less func(a, b V) bool) []Entry[K, V] {
+	return TopNByValueFunc(maps, n, func(a, b Entry[K, V]) int {
+		switch {
+		case less(b.Value, a.Value):
+			return -1
+		case less(a.Value, b.Value):
+			return 1
+		default:
+			return 0
+		}
+	})
+}
+
+// TopNByValueFunc returns at most n first entries as if sorting all
+// the entries in the ascending order by the compare function,
+// which uses a heap of size n like TopNByValue.
+//
+// For example, the entries with the greatest values, ties broken by key:
+//
+//	TopNByValueFunc(maps, n, func(a, b Entry[string, int]) int {
+//		if c := cmp.Compare(b.Value, a.Value); c != 0 {
+//			return c
+//		}
+//		return cmp.Compare(a.Key, b.Key)
+//	})
+func TopNByValueFunc[M ~map[K]V, K comparable, V any](maps M, n int, compare func(a, b Entry[K, V]) int) []Entry[K, V] {
+	if n <= 0 {
+		return []Entry[K, V]{}
+	}
+	if n > len(maps) {
+		n = len(maps)
+	}
+
+	// h is a max-heap by compare, the root of which is the last of the first n.
+	h := entryHeap[K, V]{
+		entries: make([]Entry[K, V], 0, n),
+		less:    func(a, b Entry[K, V]) bool { return compare(a, b) > 0 },
+	}
+	for k, v := range maps {
+		e := Entry[K, V]{Key: k, Value: v}
+		if len(h.entries) < n {
+			h.push(e)
+		} else if compare(e, h.entries[0]) < 0 {
+			h.entries[0] = e
+			h.down(0)
+		}
+	}
+
+	entries := h.entries
+	for i := len(entries) - 1; i > 0; i-- {
+		entries[0], entries[i] = entries[i], entries[0]
+		h.entries = entries[:i]
+		h.down(0)
+	}
+	return entries
+}
+
+type entryHeap[K comparable, V any] struct {
+	entries []Entry[K, V]
+	less    func(a, b Entry[K, V]) bool
+}
+
+func (h *entryHeap[K, V]) lessAt(i, j int) bool {
+	return h.less(h.entries[i], h.entries[j])
+}
+
+func (h *entryHeap[K, V]) push(e Entry[K, V]) {
+	h.entries = append(h.entries, e)
+	for i := len(h.entries) - 1; i > 0; {
+		parent := (i - 1) / 2
+		if !h.lessAt(i, parent) {
+			break
+		}
+		h.entries[i], h.entries[parent] = h.entries[parent], h.entries[i]
+		i = parent
+	}
+}
+
+func (h *entryHeap[K, V]) down(i int) {
+	for n := len(h.entries); ; {
+		least := i
+		if l := 2*i + 1; l < n && h.lessAt(l, least) {
+			least = l
+		}
+		if r := 2*i + 2; r < n && h.lessAt(r, least) {
+			least = r
+		}
+		if least == i {
+			return
+		}
+		h.entries[i], h.entries[least] = h.entries[least], h.entries[i]
+		i = least
+	}
+}
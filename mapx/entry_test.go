@@ -0,0 +1,97 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func ExampleEntries() {
+	scores := map[string]int{"a": 3, "b": 1, "c": 2}
+
+	entries := Entries(scores)
+	SortEntriesByKey(entries)
+	fmt.Println(entries)
+
+	SortEntriesByValue(entries)
+	fmt.Println(entries)
+
+	fmt.Println(TopNByValue(scores, 2, func(a, b int) bool { return a < b }))
+	fmt.Println(FromEntries(entries))
+
+	// Output:
+	// [{a 3} {b 1} {c 2}]
+	// [{b 1} {c 2} {a 3}]
+	// [{a 3} {c 2}]
+	// map[a:3 b:1 c:2]
+}
+
+func TestTopNByValue(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	m := make(map[int]int, 100)
+	for i := 0; i < 100; i++ {
+		m[i] = r.Intn(1000)*1000 + i // unique values
+	}
+
+	entries := Entries(m)
+	SortEntriesByValue(entries)
+	slices.Reverse(entries)
+
+	less := func(a, b int) bool { return a < b }
+	for _, n := range []int{0, 1, 10, 100, 200} {
+		expect := entries[:min(n, len(entries))]
+		if top := TopNByValue(m, n, less); !slices.Equal(top, expect) {
+			t.Errorf("n=%d: expect %v, but got %v", n, expect, top)
+		}
+	}
+
+	ties := map[string]int{"a": 1, "b": 2, "c": 2, "d": 2, "e": 3}
+	byValueThenKey := func(a, b Entry[string, int]) int {
+		if c := cmp.Compare(b.Value, a.Value); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Key, b.Key)
+	}
+	for i := 0; i < 10; i++ {
+		top := TopNByValueFunc(ties, 3, byValueThenKey)
+		if s := fmt.Sprint(top); s != "[{e 3} {b 2} {c 2}]" {
+			t.Fatalf("unexpected top entries %s", s)
+		}
+	}
+
+	type point struct{ X, Y int }
+	points := map[point]int{{2, 1}: 1, {1, 2}: 1, {0, 0}: 0}
+	for i := 0; i < 10; i++ {
+		entries := Entries(points)
+		SortEntriesByValue(entries)
+		if s := fmt.Sprint(entries); s != "[{{0 0} 0} {{1 2} 1} {{2 1} 1}]" {
+			t.Fatalf("unexpected sorted entries %s", s)
+		}
+	}
+
+	strs := EntriesFunc(map[string]int{"a": 1}, func(k string, v int) string { return fmt.Sprint(k, "=", v) })
+	if !slices.Equal(strs, []string{"a=1"}) {
+		t.Errorf("expect %v, but got %v", []string{"a=1"}, strs)
+	}
+
+	if !maps.Equal(FromEntries(Entries(m)), m) {
+		t.Errorf("expect the same map after the round trip")
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import "github.com/xgfone/go-generics/internal/order"
+
+// Filter returns a new map with the key-value pairs satisfying the predicate.
+//
+// If maps is nil, return nil.
+func Filter[M ~map[K]V, K comparable, V any](maps M, pred func(K, V) bool) M {
+	if maps == nil {
+		return nil
+	}
+
+	newmap := make(M)
+	for k, v := range maps {
+		if pred(k, v) {
+			newmap[k] = v
+		}
+	}
+	return newmap
+}
+
+// Reject is the opposite of Filter, which returns a new map
+// with the key-value pairs not satisfying the predicate.
+//
+// If maps is nil, return nil.
+func Reject[M ~map[K]V, K comparable, V any](maps M, pred func(K, V) bool) M {
+	return Filter(maps, func(k K, v V) bool { return !pred(k, v) })
+}
+
+// FilterInPlace removes the key-value pairs not satisfying the predicate
+// from the map, and returns the number of the removed pairs.
+func FilterInPlace[M ~map[K]V, K comparable, V any](maps M, pred func(K, V) bool) (removed int) {
+	for k, v := range maps {
+		if !pred(k, v) {
+			delete(maps, k)
+			removed++
+		}
+	}
+	return
+}
+
+// Partition splits the map into two new maps, the first of which contains
+// the key-value pairs satisfying the predicate, and the second contains the rest.
+//
+// If maps is nil, return nil and nil.
+func Partition[M ~map[K]V, K comparable, V any](maps M, pred func(K, V) bool) (matched, rest M) {
+	if maps == nil {
+		return
+	}
+
+	matched, rest = make(M), make(M)
+	for k, v := range maps {
+		if pred(k, v) {
+			matched[k] = v
+		} else {
+			rest[k] = v
+		}
+	}
+	return
+}
+
+// MapValues converts the values of the map and keeps the keys.
+//
+// If maps is nil, return nil.
+func MapValues[M ~map[K]V1, K comparable, V1, V2 any](maps M, convert func(K, V1) V2) map[K]V2 {
+	if maps == nil {
+		return nil
+	}
+
+	newmap := make(map[K]V2, len(maps))
+	for k, v := range maps {
+		newmap[k] = convert(k, v)
+	}
+	return newmap
+}
+
+// MapKeys converts the keys of the map and keeps the values.
+//
+// If more than one key are converted to the same new key, the resolve
+// function is used to decide the value. If resolve is nil, an arbitrary
+// one wins.
+//
+// Notice: resolve is called in the iteration order of the map, which is
+// random. So the order-dependent ones, such as KeepFirst and KeepLast,
+// are not deterministic. Use the order-independent ones, such as Sum,
+// or MapKeysStrict instead.
+//
+// If maps is nil, return nil.
+func MapKeys[M ~map[K1]V, K1, K2 comparable, V any](maps M, convert func(K1, V) K2,
+	resolve func(k K2, old, new V) V) map[K2]V {
+	if maps == nil {
+		return nil
+	}
+
+	newmap := make(map[K2]V, len(maps))
+	for k1, v := range maps {
+		k2 := convert(k1, v)
+		if old, ok := newmap[k2]; ok && resolve != nil {
+			v = resolve(k2, old, v)
+		}
+		newmap[k2] = v
+	}
+	return newmap
+}
+
+// MapKeysStrict is the same as MapKeys, but returns a *DuplicateKeyError
+// with all the sorted new keys if more than one key are converted
+// to the same new key.
+func MapKeysStrict[M ~map[K1]V, K1, K2 comparable, V any](maps M, convert func(K1, V) K2) (map[K2]V, error) {
+	if maps == nil {
+		return nil, nil
+	}
+
+	var dups map[K2]struct{}
+	newmap := make(map[K2]V, len(maps))
+	for k1, v := range maps {
+		k2 := convert(k1, v)
+		if _, ok := newmap[k2]; ok {
+			if dups == nil {
+				dups = make(map[K2]struct{})
+			}
+			dups[k2] = struct{}{}
+		}
+		newmap[k2] = v
+	}
+
+	if len(dups) > 0 {
+		return nil, newDuplicateKeyError(dups)
+	}
+	return newmap, nil
+}
+
+// Invert returns a new map from the values to the keys.
+//
+// If more than one key have the same value, the resolve function
+// is used to decide the key. If resolve is nil, an arbitrary one wins.
+//
+// Like MapKeys, resolve is called in the random iteration order of the map,
+// so the order-dependent ones are not deterministic. Use the order-independent
+// ones, such as the minimum of the keys, or InvertStrict instead.
+//
+// If maps is nil, return nil.
+func Invert[M ~map[K]V, K, V comparable](maps M, resolve func(v V, old, new K) K) map[V]K {
+	if maps == nil {
+		return nil
+	}
+
+	newmap := make(map[V]K, len(maps))
+	for k, v := range maps {
+		if old, ok := newmap[v]; ok && resolve != nil {
+			k = resolve(v, old, k)
+		}
+		newmap[v] = k
+	}
+	return newmap
+}
+
+// InvertStrict is the same as Invert, but returns a *DuplicateKeyError
+// with all the sorted duplicate values if more than one key have the same value.
+func InvertStrict[M ~map[K]V, K, V comparable](maps M) (map[V]K, error) {
+	if maps == nil {
+		return nil, nil
+	}
+
+	var dups map[V]struct{}
+	newmap := make(map[V]K, len(maps))
+	for k, v := range maps {
+		if _, ok := newmap[v]; ok {
+			if dups == nil {
+				dups = make(map[V]struct{})
+			}
+			dups[v] = struct{}{}
+		}
+		newmap[v] = k
+	}
+
+	if len(dups) > 0 {
+		return nil, newDuplicateKeyError(dups)
+	}
+	return newmap, nil
+}
+
+// PickKeys returns a new map only with the given keys which exist in the map.
+//
+// If maps is nil, return nil.
+func PickKeys[M ~map[K]V, S ~[]K, K comparable, V any](maps M, keys S) M {
+	if maps == nil {
+		return nil
+	}
+
+	newmap := make(M, len(keys))
+	for _, k := range keys {
+		if v, ok := maps[k]; ok {
+			newmap[k] = v
+		}
+	}
+	return newmap
+}
+
+// OmitKeys returns a new map without the given keys.
+//
+// If maps is nil, return nil.
+func OmitKeys[M ~map[K]V, S ~[]K, K comparable, V any](maps M, keys S) M {
+	if maps == nil {
+		return nil
+	}
+
+	newmap := make(M, len(maps))
+	for k, v := range maps {
+		newmap[k] = v
+	}
+	for _, k := range keys {
+		delete(newmap, k)
+	}
+	return newmap
+}
+
+// newDuplicateKeyError returns a *DuplicateKeyError with the sorted keys.
+func newDuplicateKeyError[K comparable](dups map[K]struct{}) *DuplicateKeyError[K] {
+	keys := Keys(dups)
+	order.Sort(keys)
+	return &DuplicateKeyError[K]{Keys: keys}
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func ExampleFilter() {
+	type Map map[string]int
+	maps := Map{"a": 1, "b": 2, "c": 3, "d": 4}
+	even := func(_ string, v int) bool { return v%2 == 0 }
+
+	fmt.Println(Filter(maps, even))
+	fmt.Println(Reject(maps, even))
+	fmt.Println(Partition(maps, even))
+	fmt.Println(PickKeys(maps, []string{"a", "z"}))
+	fmt.Println(OmitKeys(maps, []string{"a", "b"}))
+	fmt.Println(MapValues(maps, func(k string, v int) string { return strings.Repeat(k, v) }))
+
+	// Output:
+	// map[b:2 d:4]
+	// map[a:1 c:3]
+	// map[b:2 d:4] map[a:1 c:3]
+	// map[a:1]
+	// map[c:3 d:4]
+	// map[a:a b:bb c:ccc d:dddd]
+}
+
+func TestMapKeys(t *testing.T) {
+	maps := map[string]int{"a": 1, "A": 2, "b": 3}
+
+	lower := MapKeys(maps, func(k string, _ int) string { return strings.ToLower(k) }, Sum[string, int]())
+	if s := fmt.Sprint(lower); s != "map[a:3 b:3]" {
+		t.Errorf("unexpected map %s", s)
+	}
+
+	inv := Invert(map[string]int{"a": 1, "b": 1, "c": 2}, func(_ int, old, new string) string {
+		return min(old, new)
+	})
+	if s := fmt.Sprint(inv); s != "map[1:a 2:c]" {
+		t.Errorf("unexpected map %s", s)
+	}
+
+	if _, err := MapKeysStrict(maps, func(k string, _ int) string { return strings.ToLower(k) }); err == nil {
+		t.Errorf("expect a DuplicateKeyError")
+	} else if s := err.Error(); s != "mapx: duplicate keys: a" {
+		t.Errorf("unexpected error: %s", s)
+	}
+	if m, err := MapKeysStrict(maps, func(k string, _ int) string { return k + k }); err != nil || len(m) != 3 {
+		t.Errorf("unexpected result %v: %v", m, err)
+	}
+
+	if _, err := InvertStrict(map[string]int{"a": 1, "b": 1, "c": 2, "d": 2, "e": 3}); !errors.Is(err, ErrDuplicateKey) {
+		t.Errorf("expect a DuplicateKeyError, but got %v", err)
+	} else if s := err.Error(); s != "mapx: duplicate keys: 1 2" {
+		t.Errorf("unexpected error: %s", s)
+	}
+	if m, err := InvertStrict(map[string]int{"a": 1, "b": 2}); err != nil || fmt.Sprint(m) != "map[1:a 2:b]" {
+		t.Errorf("unexpected result %v: %v", m, err)
+	}
+
+	if n := FilterInPlace(maps, func(k string, _ int) bool { return k != "A" }); n != 1 || len(maps) != 2 {
+		t.Errorf("expect to remove 1 pair, but got %d: %v", n, maps)
+	}
+
+}
+
+func TestFilterNilMap(t *testing.T) {
+	var nilmap map[int]int
+	pred := func(k, _ int) bool { return k > 0 }
+	matched, rest := Partition(nilmap, pred)
+	mapKeysStrict, _ := MapKeysStrict(nilmap, func(k, _ int) int { return k })
+	invertStrict, _ := InvertStrict(nilmap)
+
+	for name, result := range map[string]bool{
+		"Filter":        Filter(nilmap, pred) == nil,
+		"Reject":        Reject(nilmap, pred) == nil,
+		"Partition":     matched == nil && rest == nil,
+		"MapValues":     MapValues(nilmap, func(int, int) int { return 0 }) == nil,
+		"MapKeys":       MapKeys(nilmap, func(k, _ int) int { return k }, nil) == nil,
+		"MapKeysStrict": mapKeysStrict == nil,
+		"Invert":        Invert(nilmap, nil) == nil,
+		"InvertStrict":  invertStrict == nil,
+		"PickKeys":      PickKeys(nilmap, []int{1}) == nil,
+		"OmitKeys":      OmitKeys(nilmap, []int{1}) == nil,
+	} {
+		if !result {
+			t.Errorf("%s: expect nil for the nil map", name)
+		}
+	}
+
+	empty := map[int]int{}
+	matched, rest = Partition(empty, pred)
+	for name, result := range map[string]bool{
+		"Filter":    Filter(empty, pred) != nil,
+		"Partition": matched != nil && rest != nil,
+		"Invert":    Invert(empty, nil) != nil,
+		"PickKeys":  PickKeys(empty, []int{1}) != nil,
+		"OmitKeys":  OmitKeys(empty, []int{1}) != nil,
+	} {
+		if !result {
+			t.Errorf("%s: expect an empty map for the empty map", name)
+		}
+	}
+}
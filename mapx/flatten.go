@@ -0,0 +1,322 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// FlattenOption is used to configure Flatten and Unflatten.
+type FlattenOption func(*flattenOptions)
+
+type flattenOptions struct {
+	escape   rune
+	brackets bool
+	maxDepth int
+}
+
+// FlattenEscape returns an option to escape the separator, the escape
+// character itself and '[' for the brackets notation in the keys
+// by the escape character, such as '\\'.
+//
+// For the multi-character separator, every occurrence of its first character
+// in the keys is escaped, such as "a\\_b" for the key "a_b" and the separator
+// "__", so that a key ending with a part of the separator is not ambiguous.
+//
+// Default: no escaping
+func FlattenEscape(escape rune) FlattenOption {
+	return func(o *flattenOptions) { o.escape = escape }
+}
+
+// FlattenBrackets returns an option to use the brackets notation
+// for the array indexes, such as "a.b[0].c" instead of "a.b.0.c".
+func FlattenBrackets() FlattenOption {
+	return func(o *flattenOptions) { o.brackets = true }
+}
+
+// FlattenMaxDepth returns an option to limit the number of the segments
+// of a flattened key, and the deeper values are kept as they are.
+//
+// Default: 0, which means no limit.
+func FlattenMaxDepth(depth int) FlattenOption {
+	return func(o *flattenOptions) { o.maxDepth = depth }
+}
+
+func newFlattenOptions(opts []FlattenOption) flattenOptions {
+	var o flattenOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// Flatten flattens the nested map, the maps and slices of which are
+// map[string]any and []any, into a flat map, the keys of which are
+// joined by sep, such as "a.b.0.c".
+//
+// The empty maps and slices are kept as the values.
+func Flatten(m map[string]any, sep string, opts ...FlattenOption) map[string]any {
+	if sep == "" {
+		panic("mapx.Flatten: the separator must not be empty")
+	}
+
+	f := flattener{flattenOptions: newFlattenOptions(opts), sep: sep, result: make(map[string]any, len(m))}
+	for k, v := range m {
+		f.flatten(f.escapeKey(k), v, 1)
+	}
+	return f.result
+}
+
+type flattener struct {
+	flattenOptions
+	sep    string
+	result map[string]any
+}
+
+func (f *flattener) escapeKey(key string) string {
+	if f.escape == 0 {
+		return key
+	}
+
+	sep, _ := utf8.DecodeRuneInString(f.sep)
+
+	var b strings.Builder
+	b.Grow(len(key) + 2)
+	for _, r := range key {
+		if r == f.escape || r == sep || (f.brackets && r == '[') {
+			b.WriteRune(f.escape)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func (f *flattener) flatten(prefix string, value any, depth int) {
+	if f.maxDepth > 0 && depth >= f.maxDepth {
+		f.result[prefix] = value
+		return
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		if len(v) == 0 {
+			f.result[prefix] = v
+			return
+		}
+
+		for k, e := range v {
+			f.flatten(prefix+f.sep+f.escapeKey(k), e, depth+1)
+		}
+
+	case []any:
+		if len(v) == 0 {
+			f.result[prefix] = v
+			return
+		}
+
+		for i, e := range v {
+			if f.brackets {
+				f.flatten(prefix+"["+strconv.Itoa(i)+"]", e, depth+1)
+			} else {
+				f.flatten(prefix+f.sep+strconv.Itoa(i), e, depth+1)
+			}
+		}
+
+	default:
+		f.result[prefix] = value
+	}
+}
+
+//////////////////////////////////////////////////////////////////////////////
+
+type flatSegment struct {
+	name  string
+	index bool // Only for the brackets notation
+}
+
+type flatNode struct {
+	value    any
+	leaf     bool
+	brackets bool // Whether the children are the bracket indexes.
+	children map[string]*flatNode
+}
+
+// Unflatten is the reverse of Flatten, which rebuilds the nested map
+// from the flat map with the same separator and options.
+//
+// For the dot notation, a map whose keys are exactly "0" to "n-1"
+// is rebuilt as a slice. For the brackets notation, only the bracket
+// indexes are rebuilt as the slices, which must be contiguous from 0.
+//
+// It returns an error if the keys conflict, such as "a" and "a.b".
+func Unflatten(m map[string]any, sep string, opts ...FlattenOption) (map[string]any, error) {
+	if sep == "" {
+		panic("mapx.Unflatten: the separator must not be empty")
+	}
+
+	o := newFlattenOptions(opts)
+	root := &flatNode{children: make(map[string]*flatNode)}
+	for key, value := range m {
+		segs, err := parseFlatKey(key, sep, o)
+		if err != nil {
+			return nil, err
+		}
+
+		node := root
+		for i, seg := range segs {
+			if node.leaf {
+				return nil, fmt.Errorf("mapx.Unflatten: key '%s' conflicts with a value", key)
+			}
+
+			if node.children == nil {
+				node.children = make(map[string]*flatNode)
+				node.brackets = seg.index
+			} else if node.brackets != seg.index {
+				return nil, fmt.Errorf("mapx.Unflatten: key '%s' mixes the array and object", key)
+			}
+
+			child, ok := node.children[seg.name]
+			if !ok {
+				child = &flatNode{}
+				node.children[seg.name] = child
+			}
+
+			if i == len(segs)-1 {
+				if child.leaf || child.children != nil {
+					return nil, fmt.Errorf("mapx.Unflatten: key '%s' conflicts with another key", key)
+				}
+				child.leaf, child.value = true, value
+			}
+			node = child
+		}
+	}
+
+	result := make(map[string]any, len(root.children))
+	for k, child := range root.children {
+		v, err := child.build(o.brackets)
+		if err != nil {
+			return nil, err
+		}
+		result[k] = v
+	}
+	return result, nil
+}
+
+func (n *flatNode) build(brackets bool) (any, error) {
+	if n.leaf {
+		return n.value, nil
+	}
+
+	_len := len(n.children)
+	isSlice := n.brackets || !brackets
+	if isSlice {
+		for i := 0; i < _len; i++ {
+			if _, ok := n.children[strconv.Itoa(i)]; !ok {
+				if n.brackets {
+					return nil, fmt.Errorf("mapx.Unflatten: the array indexes are not contiguous")
+				}
+				isSlice = false
+				break
+			}
+		}
+	}
+
+	if isSlice {
+		s := make([]any, _len)
+		for i := range s {
+			v, err := n.children[strconv.Itoa(i)].build(brackets)
+			if err != nil {
+				return nil, err
+			}
+			s[i] = v
+		}
+		return s, nil
+	}
+
+	m := make(map[string]any, _len)
+	for k, child := range n.children {
+		v, err := child.build(brackets)
+		if err != nil {
+			return nil, err
+		}
+		m[k] = v
+	}
+	return m, nil
+}
+
+func parseFlatKey(key, sep string, o flattenOptions) (segs []flatSegment, err error) {
+	for i := 0; ; {
+		var name string
+		if name, i, err = readFlatName(key, i, sep, o); err != nil {
+			return nil, err
+		}
+		segs = append(segs, flatSegment{name: name})
+
+		for o.brackets && i < len(key) && key[i] == '[' {
+			j := strings.IndexByte(key[i:], ']')
+			if j < 0 {
+				return nil, fmt.Errorf("mapx.Unflatten: key '%s' has an unclosed bracket", key)
+			}
+
+			index, err := strconv.ParseUint(key[i+1:i+j], 10, 0)
+			if err != nil {
+				return nil, fmt.Errorf("mapx.Unflatten: key '%s' has an invalid index '%s'", key, key[i+1:i+j])
+			}
+
+			segs = append(segs, flatSegment{name: strconv.FormatUint(index, 10), index: true})
+			i += j + 1
+		}
+
+		if i >= len(key) {
+			return segs, nil
+		}
+
+		if !strings.HasPrefix(key[i:], sep) {
+			return nil, fmt.Errorf("mapx.Unflatten: key '%s' has an unexpected character at %d", key, i)
+		}
+		i += len(sep)
+	}
+}
+
+// readFlatName reads the name segment from key[start:] until the separator,
+// '[' for the brackets notation or the end, and returns the unescaped name
+// and the index where the segment ends.
+func readFlatName(key string, start int, sep string, o flattenOptions) (name string, end int, err error) {
+	var b strings.Builder
+	for end = start; end < len(key); {
+		r, size := utf8.DecodeRuneInString(key[end:])
+		switch {
+		case o.escape != 0 && r == o.escape:
+			end += size
+			if end >= len(key) {
+				return "", 0, fmt.Errorf("mapx.Unflatten: key '%s' ends with the escape character", key)
+			}
+			_, size = utf8.DecodeRuneInString(key[end:])
+			b.WriteString(key[end : end+size])
+			end += size
+
+		case strings.HasPrefix(key[end:], sep), o.brackets && r == '[':
+			return b.String(), end, nil
+
+		default:
+			b.WriteString(key[end : end+size])
+			end += size
+		}
+	}
+	return b.String(), end, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func ExampleFlatten() {
+	m := map[string]any{
+		"db": map[string]any{
+			"host":    "localhost",
+			"servers": []any{map[string]any{"port": 1}, map[string]any{"port": 2}},
+		},
+	}
+
+	fmt.Println(Flatten(m, "."))
+	fmt.Println(Flatten(m, "_", FlattenBrackets()))
+	fmt.Println(Flatten(m, ".", FlattenMaxDepth(2)))
+
+	// Output:
+	// map[db.host:localhost db.servers.0.port:1 db.servers.1.port:2]
+	// map[db_host:localhost db_servers[0]_port:1 db_servers[1]_port:2]
+	// map[db.host:localhost db.servers:[map[port:1] map[port:2]]]
+}
+
+func TestFlattenRoundTrip(t *testing.T) {
+	m := map[string]any{
+		"a.b": map[string]any{"c[d]": 1, `e\f`: []any{}},
+		"g":   []any{[]any{1, 2}, map[string]any{"0": "x", "2": "y"}},
+		"h":   map[string]any{},
+		"i":   nil,
+	}
+
+	for _, opts := range [][]FlattenOption{
+		{FlattenEscape('\\')},
+		{FlattenEscape('\\'), FlattenBrackets()},
+		{FlattenEscape('\\'), FlattenMaxDepth(2)},
+	} {
+		flat := Flatten(m, ".", opts...)
+		result, err := Unflatten(flat, ".", opts...)
+		if err != nil {
+			t.Errorf("%v: %v", flat, err)
+		} else if !reflect.DeepEqual(m, result) {
+			t.Errorf("expect %v, but got %v", m, result)
+		}
+	}
+
+	multi := map[string]any{
+		"a___b": 1,
+		"c_":    map[string]any{"_d": 2, "e__": map[string]any{"f": 3}},
+		`g\__`:  []any{map[string]any{"h[0]": 4}},
+		"__":    map[string]any{"_": 5},
+	}
+	for _, opts := range [][]FlattenOption{
+		{FlattenEscape('\\')},
+		{FlattenEscape('\\'), FlattenBrackets()},
+	} {
+		flat := Flatten(multi, "__", opts...)
+		result, err := Unflatten(flat, "__", opts...)
+		if err != nil {
+			t.Errorf("%v: %v", flat, err)
+		} else if !reflect.DeepEqual(multi, result) {
+			t.Errorf("expect %v, but got %v from %v", multi, result, flat)
+		}
+	}
+
+	flat := Flatten(m, ".", FlattenEscape('\\'), FlattenBrackets())
+	if v := flat[`a\.b.c\[d]`]; v != 1 {
+		t.Errorf("expect the escaped key, but got %v", flat)
+	}
+	if v := flat["g[0][1]"]; v != 2 {
+		t.Errorf("expect the nested brackets, but got %v", flat)
+	}
+}
+
+func TestUnflattenError(t *testing.T) {
+	for _, m := range []map[string]any{
+		{"a": 1, "a.b": 2},
+		{"a.b": 1, "a": 2},
+		{"a[0]": 1, "a.b": 2},
+		{"a[1]": 1},
+		{"a[x]": 1},
+		{"a[0": 1},
+		{"a[0]b": 1},
+		{`a\`: 1},
+	} {
+		if v, err := Unflatten(m, ".", FlattenBrackets(), FlattenEscape('\\')); err == nil {
+			t.Errorf("%v: expect an error, but got %v", m, v)
+		}
+	}
+
+	v, err := Unflatten(map[string]any{"a.0": 1, "a.2": 2}, ".")
+	if err != nil {
+		t.Fatal(err)
+	} else if s := fmt.Sprint(v); s != "map[a:map[0:1 2:2]]" {
+		t.Errorf("expect a map for the non-contiguous indexes, but got %s", s)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+// GroupBy groups the elements of the slice by the key function,
+// and the elements of each group keep their order in the slice.
+func GroupBy[S ~[]E, K comparable, E any](s S, getkey func(E) K) map[K][]E {
+	return FromSliceAll(s, func(e E) (K, E) { return getkey(e), e })
+}
+
+// GroupByFunc is the same as GroupBy, but projects each element
+// to the value by the project function.
+//
+// It is the same as FromSliceAll, but with the separate key and value functions.
+func GroupByFunc[S ~[]E, K comparable, V, E any](s S, getkey func(E) K, project func(E) V) map[K][]V {
+	return FromSliceAll(s, func(e E) (K, V) { return getkey(e), project(e) })
+}
+
+// CountBy counts the elements of the slice by the key function.
+func CountBy[S ~[]E, K comparable, E any](s S, getkey func(E) K) map[K]int {
+	counts := make(map[K]int)
+	for _, e := range s {
+		counts[getkey(e)]++
+	}
+	return counts
+}
+
+// SumBy sums the numbers projected from the elements of the slice
+// by the key function.
+func SumBy[S ~[]E, K comparable, N Number, E any](s S, getkey func(E) K, getnum func(E) N) map[K]N {
+	sums := make(map[K]N)
+	for _, e := range s {
+		sums[getkey(e)] += getnum(e)
+	}
+	return sums
+}
+
+// IndexBy indexes the elements of the slice by the unique key.
+//
+// If more than one element have the same key, return a *DuplicateKeyError
+// with all the duplicate keys. It is the same as FromSliceStrict,
+// but only with the key function.
+func IndexBy[S ~[]E, K comparable, E any](s S, getkey func(E) K) (map[K]E, error) {
+	return FromSliceStrict(s, func(e E) (K, E) { return getkey(e), e })
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func ExampleGroupBy() {
+	type Order struct {
+		ID     int
+		User   string
+		Amount float64
+	}
+
+	orders := []Order{
+		{ID: 1, User: "a", Amount: 1.5},
+		{ID: 2, User: "b", Amount: 2},
+		{ID: 3, User: "a", Amount: 3},
+	}
+
+	user := func(o Order) string { return o.User }
+	fmt.Println(GroupBy(orders, user))
+	fmt.Println(GroupByFunc(orders, user, func(o Order) int { return o.ID }))
+	fmt.Println(CountBy(orders, user))
+	fmt.Println(SumBy(orders, user, func(o Order) float64 { return o.Amount }))
+
+	index, err := IndexBy(orders, func(o Order) int { return o.ID })
+	fmt.Println(len(index), err)
+
+	_, err = IndexBy(orders, user)
+	fmt.Println(err)
+
+	// Output:
+	// map[a:[{1 a 1.5} {3 a 3}] b:[{2 b 2}]]
+	// map[a:[1 3] b:[2]]
+	// map[a:2 b:1]
+	// map[a:4.5 b:2]
+	// 3 <nil>
+	// mapx: duplicate keys: a
+}
+
+func TestGroupBy(t *testing.T) {
+	type item struct {
+		Key   string
+		Value *int
+	}
+
+	one := 1
+	getkey := func(e item) string { return e.Key }
+	project := func(e item) *int { return e.Value }
+
+	tests := []struct {
+		name   string
+		items  []item
+		groups string
+		values string
+		dups   []string
+	}{
+		{"nil", nil, "map[]", "map[]", nil},
+		{"empty", []item{}, "map[]", "map[]", nil},
+		{"nil projections", []item{{"a", nil}, {"b", nil}}, "map[a:1 b:1]", "map[a:[<nil>] b:[<nil>]]", nil},
+		{"unique", []item{{"a", &one}, {"b", nil}}, "map[a:1 b:1]", "map[a:[1] b:[<nil>]]", nil},
+		{
+			"duplicates",
+			[]item{{"b", nil}, {"a", &one}, {"b", &one}, {"c", nil}, {"a", nil}, {"b", nil}},
+			"map[a:2 b:3 c:1]", "map[a:[1 <nil>] b:[<nil> 1 <nil>] c:[<nil>]]",
+			[]string{"b", "a"},
+		},
+	}
+
+	for _, test := range tests {
+		groups := GroupBy(test.items, getkey)
+		if groups == nil {
+			t.Errorf("%s: expect an empty map, but got nil", test.name)
+		}
+		if s := fmt.Sprint(MapValues(groups, func(_ string, v []item) int { return len(v) })); s != test.groups {
+			t.Errorf("%s: expect groups %s, but got %s", test.name, test.groups, s)
+		}
+
+		values := GroupByFunc(test.items, getkey, project)
+		s := fmt.Sprint(MapValues(values, func(_ string, v []*int) []string {
+			strs := make([]string, len(v))
+			for i, p := range v {
+				if strs[i] = "<nil>"; p != nil {
+					strs[i] = fmt.Sprint(*p)
+				}
+			}
+			return strs
+		}))
+		if s != test.values {
+			t.Errorf("%s: expect values %s, but got %s", test.name, test.values, s)
+		}
+
+		index, err := IndexBy(test.items, getkey)
+		var derr *DuplicateKeyError[string]
+		switch {
+		case test.dups == nil && err != nil:
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		case test.dups == nil && len(index) != len(groups):
+			t.Errorf("%s: expect %d indexes, but got %d", test.name, len(groups), len(index))
+		case test.dups != nil && !errors.As(err, &derr):
+			t.Errorf("%s: expect a DuplicateKeyError, but got %v", test.name, err)
+		case test.dups != nil && !slices.Equal(derr.Keys, test.dups):
+			t.Errorf("%s: expect the duplicate keys %v, but got %v", test.name, test.dups, derr.Keys)
+		}
+	}
+}
@@ -15,6 +15,18 @@
 // Package mapx provides some extra map generic functions.
 package mapx
 
+import (
+	"cmp"
+	"slices"
+)
+
+// Number is the constraint of the integer and float types.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
 // TryAdd tries to add the key-value pair into the maps
 // if the key does not exist. Or, do nothing and return false.
 func TryAdd[M ~map[K]V, K comparable, V any](maps M, k K, v V) (ok bool) {
@@ -79,6 +91,11 @@ func Convert[M ~map[K1]V1, K1, K2 comparable, V1, V2 any](maps M, convert func(K
 	return newmap
 }
 
+// FromSliceWithIndex converts a slice s to a map by the conversion function,
+// which is passed the index and the element.
+//
+// If more than one element are converted to the same key, the last one wins.
+// See FromSliceWithIndexStrict, FromSliceWithIndexFirst and FromSliceWithIndexAll.
 func FromSliceWithIndex[S ~[]E, K comparable, V, E any](s S, convert func(int, E) (K, V)) map[K]V {
 	_len := len(s)
 	maps := make(map[K]V, _len)
@@ -89,6 +106,10 @@ func FromSliceWithIndex[S ~[]E, K comparable, V, E any](s S, convert func(int, E
 	return maps
 }
 
+// FromSlice converts a slice s to a map by the conversion function.
+//
+// If more than one element are converted to the same key, the last one wins.
+// See FromSliceStrict, FromSliceFirst and FromSliceAll.
 func FromSlice[S ~[]E, K comparable, V, E any](s S, convert func(E) (K, V)) map[K]V {
 	return FromSliceWithIndex(s, func(_ int, e E) (K, V) { return convert(e) })
 }
@@ -148,3 +169,70 @@ func ValuesFunc[M ~map[K]V, T any, K comparable, V any](maps M, convert func(V)
 	}
 	return values
 }
+
+// AppendKeys appends all the keys of the map to dst and returns the extended slice.
+func AppendKeys[S ~[]K, M ~map[K]V, K comparable, V any](dst S, maps M) S {
+	dst = slices.Grow(dst, len(maps))
+	for k := range maps {
+		dst = append(dst, k)
+	}
+	return dst
+}
+
+// AppendValues appends all the values of the map to dst and returns the extended slice.
+func AppendValues[S ~[]V, M ~map[K]V, K comparable, V any](dst S, maps M) S {
+	dst = slices.Grow(dst, len(maps))
+	for _, v := range maps {
+		dst = append(dst, v)
+	}
+	return dst
+}
+
+// SortedKeys returns all the keys of the map in the ascending order.
+func SortedKeys[M ~map[K]V, K cmp.Ordered, V any](maps M) []K {
+	keys := Keys(maps)
+	slices.Sort(keys)
+	return keys
+}
+
+// SortedKeysFunc returns all the keys of the map sorted by the compare function.
+func SortedKeysFunc[M ~map[K]V, K comparable, V any](maps M, compare func(a, b K) int) []K {
+	keys := Keys(maps)
+	slices.SortFunc(keys, compare)
+	return keys
+}
+
+// AppendSortedKeys is the same as SortedKeys, but appends the sorted keys
+// to dst and returns the extended slice. Only the appended keys are sorted.
+func AppendSortedKeys[S ~[]K, M ~map[K]V, K cmp.Ordered, V any](dst S, maps M) S {
+	start := len(dst)
+	dst = AppendKeys(dst, maps)
+	slices.Sort(dst[start:])
+	return dst
+}
+
+// ValuesByKeyOrder returns all the values of the map
+// in the ascending order of their keys.
+func ValuesByKeyOrder[M ~map[K]V, K cmp.Ordered, V any](maps M) []V {
+	return AppendValuesByKeyOrder(make([]V, 0, len(maps)), maps)
+}
+
+// ValuesByKeyOrderFunc returns all the values of the map
+// in the order of their keys sorted by the compare function.
+func ValuesByKeyOrderFunc[M ~map[K]V, K comparable, V any](maps M, compare func(a, b K) int) []V {
+	values := make([]V, 0, len(maps))
+	for _, k := range SortedKeysFunc(maps, compare) {
+		values = append(values, maps[k])
+	}
+	return values
+}
+
+// AppendValuesByKeyOrder is the same as ValuesByKeyOrder, but appends
+// the values to dst and returns the extended slice.
+func AppendValuesByKeyOrder[S ~[]V, M ~map[K]V, K cmp.Ordered, V any](dst S, maps M) S {
+	dst = slices.Grow(dst, len(maps))
+	for _, k := range SortedKeys(maps) {
+		dst = append(dst, maps[k])
+	}
+	return dst
+}
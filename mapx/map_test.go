@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"slices"
 	"sort"
+	"strings"
 	"testing"
 )
 
@@ -217,3 +218,49 @@ func ExampleBoolMapFunc() {
 	// Output:
 	// map[a:true b:true c:true]
 }
+
+func ExampleSortedKeys() {
+	maps := map[string]int{"c": 1, "a": 3, "b": 2}
+
+	fmt.Println(SortedKeys(maps))
+	fmt.Println(SortedKeysFunc(maps, func(a, b string) int { return strings.Compare(b, a) }))
+	fmt.Println(ValuesByKeyOrder(maps))
+	fmt.Println(ValuesByKeyOrderFunc(maps, func(a, b string) int { return maps[a] - maps[b] }))
+
+	// Output:
+	// [a b c]
+	// [c b a]
+	// [3 2 1]
+	// [1 2 3]
+}
+
+func TestAppendKeys(t *testing.T) {
+	buf := make([]string, 0, 8)
+	maps := map[string]int{"c": 1, "a": 3, "b": 2}
+
+	keys := AppendSortedKeys(append(buf, "z"), maps)
+	if !slices.Equal(keys, []string{"z", "a", "b", "c"}) {
+		t.Errorf("expect %v, but got %v", []string{"z", "a", "b", "c"}, keys)
+	}
+	if &keys[0] != &buf[:1][0] {
+		t.Errorf("expect to reuse the buffer")
+	}
+
+	if keys = AppendKeys(keys[:0], maps); len(keys) != 3 {
+		t.Errorf("expect %d keys, but got %v", 3, keys)
+	}
+
+	values := AppendValues([]int{0}, maps)
+	slices.Sort(values)
+	if !slices.Equal(values, []int{0, 1, 2, 3}) {
+		t.Errorf("expect %v, but got %v", []int{0, 1, 2, 3}, values)
+	}
+
+	if values = AppendValuesByKeyOrder(values[:1], maps); !slices.Equal(values, []int{0, 3, 2, 1}) {
+		t.Errorf("expect %v, but got %v", []int{0, 3, 2, 1}, values)
+	}
+
+	if allocs := testing.AllocsPerRun(10, func() { keys = AppendKeys(keys[:0], maps) }); allocs != 0 {
+		t.Errorf("expect no allocation, but got %v", allocs)
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import "github.com/xgfone/go-generics/internal/order"
+
+// Merge merges all the key-value pairs of srcs into dst in turn,
+// the later value of which overrides the earlier one, and returns dst.
+//
+// If dst is nil, a new map is created.
+func Merge[M ~map[K]V, K comparable, V any](dst M, srcs ...M) M {
+	dst = mergeDst(dst, srcs)
+	for _, src := range srcs {
+		for k, v := range src {
+			dst[k] = v
+		}
+	}
+	return dst
+}
+
+// MergeFunc is the same as Merge, but uses the resolve function
+// to decide the value when the key has existed in dst.
+//
+// Some strategies are provided, such as KeepFirst, KeepLast and Sum.
+func MergeFunc[M ~map[K]V, K comparable, V any](dst M, resolve func(k K, old, new V) V, srcs ...M) M {
+	dst = mergeDst(dst, srcs)
+	for _, src := range srcs {
+		for k, v := range src {
+			if old, ok := dst[k]; ok {
+				v = resolve(k, old, v)
+			}
+			dst[k] = v
+		}
+	}
+	return dst
+}
+
+// MergeStrict is the same as Merge, but returns a *DuplicateKeyError
+// with all the sorted conflicting keys if any key exists in more than one map,
+// and dst is not modified.
+func MergeStrict[M ~map[K]V, K comparable, V any](dst M, srcs ...M) (M, error) {
+	var conflicts []K
+	seen := make(map[K]bool) // true means the key is conflicting.
+	for _, src := range srcs {
+		for k := range src {
+			conflicting, ok := seen[k]
+			if !ok {
+				_, ok = dst[k]
+			}
+
+			if ok && !conflicting {
+				conflicts = append(conflicts, k)
+			}
+			seen[k] = ok
+		}
+	}
+
+	if len(conflicts) > 0 {
+		order.Sort(conflicts)
+		return dst, &DuplicateKeyError[K]{Keys: conflicts}
+	}
+	return Merge(dst, srcs...), nil
+}
+
+func mergeDst[M ~map[K]V, K comparable, V any](dst M, srcs []M) M {
+	if dst == nil {
+		var size int
+		for _, src := range srcs {
+			size += len(src)
+		}
+		dst = make(M, size)
+	}
+	return dst
+}
+
+// KeepFirst returns a resolve function for MergeFunc, which keeps the old value.
+func KeepFirst[K comparable, V any]() func(k K, old, new V) V {
+	return func(_ K, old, _ V) V { return old }
+}
+
+// KeepLast returns a resolve function for MergeFunc, which uses the new value.
+func KeepLast[K comparable, V any]() func(k K, old, new V) V {
+	return func(_ K, _, new V) V { return new }
+}
+
+// Sum returns a resolve function for MergeFunc, which sums the values.
+func Sum[K comparable, V Number]() func(k K, old, new V) V {
+	return func(_ K, old, new V) V { return old + new }
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleMerge() {
+	m1 := map[string]int{"a": 1, "b": 2}
+	m2 := map[string]int{"b": 3, "c": 4}
+
+	fmt.Println(Merge(nil, m1, m2))
+	fmt.Println(MergeFunc(nil, KeepFirst[string, int](), m1, m2))
+	fmt.Println(MergeFunc(nil, Sum[string, int](), m1, m2))
+
+	_, err := MergeStrict(nil, m1, m2)
+	fmt.Println(err)
+
+	// Output:
+	// map[a:1 b:3 c:4]
+	// map[a:1 b:2 c:4]
+	// map[a:1 b:5 c:4]
+	// mapx: duplicate keys: b
+}
+
+func TestMergeStrict(t *testing.T) {
+	dst := map[string]int{"a": 1}
+	_, err := MergeStrict(dst, map[string]int{"a": 2, "b": 1}, map[string]int{"a": 3, "b": 2, "c": 3})
+
+	var derr *DuplicateKeyError[string]
+	if !errors.As(err, &derr) || !errors.Is(err, ErrDuplicateKey) {
+		t.Fatalf("expect a DuplicateKeyError, but got %v", err)
+	}
+	if s := err.Error(); s != "mapx: duplicate keys: a b" {
+		t.Errorf("unexpected error: %s", s)
+	}
+	if len(dst) != 1 {
+		t.Errorf("expect dst not to be modified, but got %v", dst)
+	}
+
+	dst, err = MergeStrict(dst, map[string]int{"b": 2})
+	if err != nil || len(dst) != 2 {
+		t.Errorf("unexpected result %v, %v", dst, err)
+	}
+
+	last := MergeFunc(dst, KeepLast[string, int](), map[string]int{"b": 3})
+	if last["b"] != 3 {
+		t.Errorf("expect %d, but got %d", 3, last["b"])
+	}
+}
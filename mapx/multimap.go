@@ -0,0 +1,340 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import "slices"
+
+// MultiMap is a map from a key to multiple values.
+//
+// It is not thread-safe.
+type MultiMap[K, V comparable] interface {
+	// Put adds the value of the key, and reports whether it is added.
+	Put(k K, v V) bool
+
+	// PutAll adds all the values of the key, and returns the number of the added values.
+	PutAll(k K, vs ...V) int
+
+	// Get returns a copy of the values of the key.
+	Get(k K) []V
+
+	// Contains reports whether the key-value pair exists.
+	Contains(k K, v V) bool
+
+	// ContainsKey reports whether the key has any value.
+	ContainsKey(k K) bool
+
+	// Remove removes the value of the key, and reports whether it is removed.
+	Remove(k K, v V) bool
+
+	// RemoveAll removes and returns all the values of the key.
+	RemoveAll(k K) []V
+
+	// Count returns the number of the values of the key.
+	Count(k K) int
+
+	// Len returns the number of all the key-value pairs.
+	Len() int
+
+	// Keys returns all the keys which have at least one value.
+	Keys() []K
+
+	// Entries returns all the key-value pairs.
+	Entries() []Entry[K, V]
+
+	// Range travels all the key-value pairs until f returns false.
+	Range(f func(k K, v V) bool)
+
+	// Inverse returns a new multimap of the same variant from the values to the keys.
+	Inverse() MultiMap[V, K]
+}
+
+var (
+	_ MultiMap[int, int] = new(ListMultiMap[int, int])
+	_ MultiMap[int, int] = new(SetMultiMap[int, int])
+)
+
+func multimapEntries[K, V comparable](m MultiMap[K, V]) []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, m.Len())
+	m.Range(func(k K, v V) bool {
+		entries = append(entries, Entry[K, V]{Key: k, Value: v})
+		return true
+	})
+	return entries
+}
+
+//////////////////////////////////////////////////////////////////////////////
+
+// ListMultiMap is a list-valued multimap, which keeps the insertion order
+// of the values of each key and allows the duplicate values.
+type ListMultiMap[K, V comparable] struct {
+	maps map[K][]V
+	size int
+}
+
+// NewListMultiMap returns a new ListMultiMap with the initialization capacity.
+func NewListMultiMap[K, V comparable](cap int) *ListMultiMap[K, V] {
+	return &ListMultiMap[K, V]{maps: make(map[K][]V, cap)}
+}
+
+// ListMultiMapFromSlice converts a slice s to a ListMultiMap,
+// which groups the elements by the key function.
+func ListMultiMapFromSlice[S ~[]V, K, V comparable](s S, getkey func(V) K) *ListMultiMap[K, V] {
+	m := NewListMultiMap[K, V](0)
+	for _, v := range s {
+		m.Put(getkey(v), v)
+	}
+	return m
+}
+
+// Put appends the value of the key, which always returns true.
+func (m *ListMultiMap[K, V]) Put(k K, v V) bool {
+	m.maps[k] = append(m.maps[k], v)
+	m.size++
+	return true
+}
+
+// PutAll appends all the values of the key.
+func (m *ListMultiMap[K, V]) PutAll(k K, vs ...V) int {
+	if len(vs) > 0 {
+		m.maps[k] = append(m.maps[k], vs...)
+		m.size += len(vs)
+	}
+	return len(vs)
+}
+
+// Get returns a copy of the values of the key in the insertion order.
+func (m *ListMultiMap[K, V]) Get(k K) []V {
+	return slices.Clone(m.maps[k])
+}
+
+// Contains reports whether the key-value pair exists.
+func (m *ListMultiMap[K, V]) Contains(k K, v V) bool {
+	return slices.Contains(m.maps[k], v)
+}
+
+// ContainsKey reports whether the key has any value.
+func (m *ListMultiMap[K, V]) ContainsKey(k K) bool {
+	_, ok := m.maps[k]
+	return ok
+}
+
+// Remove removes the first occurrence of the value of the key.
+func (m *ListMultiMap[K, V]) Remove(k K, v V) bool {
+	vs := m.maps[k]
+	i := slices.Index(vs, v)
+	if i < 0 {
+		return false
+	}
+
+	if vs = slices.Delete(vs, i, i+1); len(vs) == 0 {
+		delete(m.maps, k)
+	} else {
+		m.maps[k] = vs
+	}
+	m.size--
+	return true
+}
+
+// RemoveAll removes and returns all the values of the key.
+func (m *ListMultiMap[K, V]) RemoveAll(k K) []V {
+	vs, ok := m.maps[k]
+	if ok {
+		delete(m.maps, k)
+		m.size -= len(vs)
+	}
+	return vs
+}
+
+// Count returns the number of the values of the key.
+func (m *ListMultiMap[K, V]) Count(k K) int {
+	return len(m.maps[k])
+}
+
+// Len returns the number of all the key-value pairs.
+func (m *ListMultiMap[K, V]) Len() int {
+	return m.size
+}
+
+// Keys returns all the keys which have at least one value.
+func (m *ListMultiMap[K, V]) Keys() []K {
+	return Keys(m.maps)
+}
+
+// Entries returns all the key-value pairs.
+func (m *ListMultiMap[K, V]) Entries() []Entry[K, V] {
+	return multimapEntries[K, V](m)
+}
+
+// Range travels all the key-value pairs until f returns false.
+func (m *ListMultiMap[K, V]) Range(f func(k K, v V) bool) {
+	for k, vs := range m.maps {
+		for _, v := range vs {
+			if !f(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Inverse returns a new ListMultiMap from the values to the keys.
+func (m *ListMultiMap[K, V]) Inverse() MultiMap[V, K] {
+	inv := NewListMultiMap[V, K](len(m.maps))
+	m.Range(func(k K, v V) bool { inv.Put(v, k); return true })
+	return inv
+}
+
+// Map returns a copy of the multimap as a builtin map.
+func (m *ListMultiMap[K, V]) Map() map[K][]V {
+	return Convert(m.maps, func(k K, vs []V) (K, []V) { return k, slices.Clone(vs) })
+}
+
+//////////////////////////////////////////////////////////////////////////////
+
+// SetMultiMap is a set-valued multimap, the values of each key of which
+// are unique and unordered.
+type SetMultiMap[K, V comparable] struct {
+	maps map[K]map[V]struct{}
+	size int
+}
+
+// NewSetMultiMap returns a new SetMultiMap with the initialization capacity.
+func NewSetMultiMap[K, V comparable](cap int) *SetMultiMap[K, V] {
+	return &SetMultiMap[K, V]{maps: make(map[K]map[V]struct{}, cap)}
+}
+
+// SetMultiMapFromSlice converts a slice s to a SetMultiMap,
+// which groups the elements by the key function.
+func SetMultiMapFromSlice[S ~[]V, K, V comparable](s S, getkey func(V) K) *SetMultiMap[K, V] {
+	m := NewSetMultiMap[K, V](0)
+	for _, v := range s {
+		m.Put(getkey(v), v)
+	}
+	return m
+}
+
+// Put adds the value of the key, and reports whether it does not exist before.
+func (m *SetMultiMap[K, V]) Put(k K, v V) bool {
+	vs, ok := m.maps[k]
+	if !ok {
+		vs = make(map[V]struct{})
+		m.maps[k] = vs
+	} else if _, ok = vs[v]; ok {
+		return false
+	}
+
+	vs[v] = struct{}{}
+	m.size++
+	return true
+}
+
+// PutAll adds all the values of the key, and returns the number of the added values.
+func (m *SetMultiMap[K, V]) PutAll(k K, vs ...V) (n int) {
+	for _, v := range vs {
+		if m.Put(k, v) {
+			n++
+		}
+	}
+	return
+}
+
+// Get returns the values of the key in an arbitrary order.
+func (m *SetMultiMap[K, V]) Get(k K) []V {
+	vs, ok := m.maps[k]
+	if !ok {
+		return nil
+	}
+	return Keys(vs)
+}
+
+// Contains reports whether the key-value pair exists.
+func (m *SetMultiMap[K, V]) Contains(k K, v V) bool {
+	_, ok := m.maps[k][v]
+	return ok
+}
+
+// ContainsKey reports whether the key has any value.
+func (m *SetMultiMap[K, V]) ContainsKey(k K) bool {
+	_, ok := m.maps[k]
+	return ok
+}
+
+// Remove removes the value of the key.
+func (m *SetMultiMap[K, V]) Remove(k K, v V) bool {
+	vs := m.maps[k]
+	if _, ok := vs[v]; !ok {
+		return false
+	}
+
+	if delete(vs, v); len(vs) == 0 {
+		delete(m.maps, k)
+	}
+	m.size--
+	return true
+}
+
+// RemoveAll removes and returns all the values of the key.
+func (m *SetMultiMap[K, V]) RemoveAll(k K) []V {
+	vs, ok := m.maps[k]
+	if !ok {
+		return nil
+	}
+
+	delete(m.maps, k)
+	m.size -= len(vs)
+	return Keys(vs)
+}
+
+// Count returns the number of the values of the key.
+func (m *SetMultiMap[K, V]) Count(k K) int {
+	return len(m.maps[k])
+}
+
+// Len returns the number of all the key-value pairs.
+func (m *SetMultiMap[K, V]) Len() int {
+	return m.size
+}
+
+// Keys returns all the keys which have at least one value.
+func (m *SetMultiMap[K, V]) Keys() []K {
+	return Keys(m.maps)
+}
+
+// Entries returns all the key-value pairs.
+func (m *SetMultiMap[K, V]) Entries() []Entry[K, V] {
+	return multimapEntries[K, V](m)
+}
+
+// Range travels all the key-value pairs until f returns false.
+func (m *SetMultiMap[K, V]) Range(f func(k K, v V) bool) {
+	for k, vs := range m.maps {
+		for v := range vs {
+			if !f(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Inverse returns a new SetMultiMap from the values to the keys.
+func (m *SetMultiMap[K, V]) Inverse() MultiMap[V, K] {
+	inv := NewSetMultiMap[V, K](len(m.maps))
+	m.Range(func(k K, v V) bool { inv.Put(v, k); return true })
+	return inv
+}
+
+// Map returns a copy of the multimap as a builtin map.
+func (m *SetMultiMap[K, V]) Map() map[K][]V {
+	return Convert(m.maps, func(k K, vs map[V]struct{}) (K, []V) { return k, Keys(vs) })
+}
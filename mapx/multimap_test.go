@@ -0,0 +1,86 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func ExampleListMultiMap() {
+	words := []string{"apple", "avocado", "banana", "apple"}
+	m := ListMultiMapFromSlice(words, func(s string) byte { return s[0] })
+
+	fmt.Println(m.Get('a'))
+	fmt.Println(m.Count('a'), m.Count('b'), m.Len())
+
+	m.Remove('a', "apple")
+	fmt.Println(m.Get('a'))
+
+	// Output:
+	// [apple avocado apple]
+	// 3 1 4
+	// [avocado apple]
+}
+
+func testMultiMap(t *testing.T, m MultiMap[string, int], dups bool) {
+	m.PutAll("a", 1, 2, 2)
+	m.Put("b", 1)
+
+	expectLen := 3
+	if dups {
+		expectLen = 4
+	}
+	if m.Len() != expectLen {
+		t.Errorf("expect %d pairs, but got %d", expectLen, m.Len())
+	}
+	if !m.Contains("a", 2) || m.Contains("b", 2) || !m.ContainsKey("b") {
+		t.Errorf("unexpected Contains result")
+	}
+	if n := len(m.Entries()); n != expectLen {
+		t.Errorf("expect %d entries, but got %d", expectLen, n)
+	}
+
+	inv := m.Inverse()
+	keys := inv.Get(1)
+	slices.Sort(keys)
+	if !slices.Equal(keys, []string{"a", "b"}) {
+		t.Errorf("expect %v, but got %v", []string{"a", "b"}, keys)
+	}
+
+	if !m.Remove("b", 1) || m.Remove("b", 1) || m.ContainsKey("b") {
+		t.Errorf("fail to remove the value")
+	}
+	if vs := m.RemoveAll("a"); len(vs) != expectLen-1 {
+		t.Errorf("expect %d values, but got %v", expectLen-1, vs)
+	}
+	if m.Len() != 0 || len(m.Keys()) != 0 {
+		t.Errorf("expect the empty multimap")
+	}
+}
+
+func TestListMultiMap(t *testing.T) {
+	testMultiMap(t, NewListMultiMap[string, int](0), true)
+}
+
+func TestSetMultiMap(t *testing.T) {
+	testMultiMap(t, NewSetMultiMap[string, int](0), false)
+
+	m := SetMultiMapFromSlice([]int{1, 2, 3, 4, 3}, func(v int) bool { return v%2 == 0 })
+	if m.Len() != 4 || m.Count(false) != 2 {
+		t.Errorf("unexpected multimap %v", m.Map())
+	}
+}
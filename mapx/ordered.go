@@ -0,0 +1,433 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+type orderedNode[K comparable, V any] struct {
+	prev, next *orderedNode[K, V]
+	key        K
+	value      V
+}
+
+// OrderedMap is a map which remembers the insertion order of the keys.
+//
+// The zero value is an empty map and ready to use. But it is not thread-safe,
+// and should be used by the pointer.
+type OrderedMap[K comparable, V any] struct {
+	nodes map[K]*orderedNode[K, V]
+	root  orderedNode[K, V] // root.next is the first, and root.prev is the last.
+}
+
+// NewOrderedMap returns a new OrderedMap with the initialization capacity.
+func NewOrderedMap[K comparable, V any](cap int) *OrderedMap[K, V] {
+	m := &OrderedMap[K, V]{nodes: make(map[K]*orderedNode[K, V], cap)}
+	m.init()
+	return m
+}
+
+func (m *OrderedMap[K, V]) init() {
+	if m.nodes == nil {
+		m.nodes = make(map[K]*orderedNode[K, V])
+	}
+	if m.root.next == nil {
+		m.root.next = &m.root
+		m.root.prev = &m.root
+	}
+}
+
+// unlink removes the node from the list, but keeps its links
+// so that Range and RangeReverse can find the neighbors of the removed node.
+func (m *OrderedMap[K, V]) unlink(n *orderedNode[K, V]) {
+	n.prev.next = n.next
+	n.next.prev = n.prev
+}
+
+// linked reports whether the node is still in the map.
+func (m *OrderedMap[K, V]) linked(n *orderedNode[K, V]) bool {
+	return n == &m.root || m.nodes[n.key] == n
+}
+
+func (m *OrderedMap[K, V]) pushBack(n *orderedNode[K, V]) {
+	n.prev = m.root.prev
+	n.next = &m.root
+	n.prev.next = n
+	m.root.prev = n
+}
+
+func (m *OrderedMap[K, V]) pushFront(n *orderedNode[K, V]) {
+	n.prev = &m.root
+	n.next = m.root.next
+	n.next.prev = n
+	m.root.next = n
+}
+
+// Len returns the number of the key-value pairs.
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.nodes)
+}
+
+// Set sets the value of the key.
+//
+// If the key has existed, only update its value and keep its position.
+// Or, append it to the end.
+func (m *OrderedMap[K, V]) Set(k K, v V) {
+	if n, ok := m.nodes[k]; ok {
+		n.value = v
+		return
+	}
+
+	m.init()
+	n := &orderedNode[K, V]{key: k, value: v}
+	m.nodes[k] = n
+	m.pushBack(n)
+}
+
+// Get returns the value of the key.
+func (m *OrderedMap[K, V]) Get(k K) (v V, ok bool) {
+	if n, exist := m.nodes[k]; exist {
+		v, ok = n.value, true
+	}
+	return
+}
+
+// Contains reports whether the key exists.
+func (m *OrderedMap[K, V]) Contains(k K) bool {
+	_, ok := m.nodes[k]
+	return ok
+}
+
+// Delete removes the key and returns the removed value.
+func (m *OrderedMap[K, V]) Delete(k K) (v V, ok bool) {
+	n, ok := m.nodes[k]
+	if ok {
+		delete(m.nodes, k)
+		m.unlink(n)
+		v = n.value
+	}
+	return
+}
+
+// Clear removes all the key-value pairs.
+func (m *OrderedMap[K, V]) Clear() {
+	clear(m.nodes)
+	m.root.next = &m.root
+	m.root.prev = &m.root
+}
+
+// MoveToEnd moves the key to the end and reports whether it exists.
+func (m *OrderedMap[K, V]) MoveToEnd(k K) (ok bool) {
+	n, ok := m.nodes[k]
+	if ok && m.root.prev != n {
+		m.unlink(n)
+		m.pushBack(n)
+	}
+	return
+}
+
+// MoveToFront moves the key to the front and reports whether it exists.
+func (m *OrderedMap[K, V]) MoveToFront(k K) (ok bool) {
+	n, ok := m.nodes[k]
+	if ok && m.root.next != n {
+		m.unlink(n)
+		m.pushFront(n)
+	}
+	return
+}
+
+// First returns the first key-value pair.
+func (m *OrderedMap[K, V]) First() (k K, v V, ok bool) {
+	if len(m.nodes) > 0 {
+		n := m.root.next
+		k, v, ok = n.key, n.value, true
+	}
+	return
+}
+
+// Last returns the last key-value pair.
+func (m *OrderedMap[K, V]) Last() (k K, v V, ok bool) {
+	if len(m.nodes) > 0 {
+		n := m.root.prev
+		k, v, ok = n.key, n.value, true
+	}
+	return
+}
+
+// Range travels all the key-value pairs in order until f returns false.
+//
+// f may delete any keys, including the current one, and the deleted keys
+// that have not been visited are skipped. The keys added or moved by f
+// may or may not be visited.
+func (m *OrderedMap[K, V]) Range(f func(k K, v V) bool) {
+	if len(m.nodes) == 0 {
+		return
+	}
+
+	for n := m.root.next; n != &m.root; {
+		if !f(n.key, n.value) {
+			return
+		}
+
+		// The removed nodes keep their links, which lead to a node
+		// still in the map or the root finally.
+		for n = n.next; !m.linked(n); n = n.next {
+		}
+	}
+}
+
+// RangeReverse is the same as Range, but travels them in the reverse order.
+func (m *OrderedMap[K, V]) RangeReverse(f func(k K, v V) bool) {
+	if len(m.nodes) == 0 {
+		return
+	}
+
+	for n := m.root.prev; n != &m.root; {
+		if !f(n.key, n.value) {
+			return
+		}
+		for n = n.prev; !m.linked(n); n = n.prev {
+		}
+	}
+}
+
+// Keys returns all the keys in order.
+func (m *OrderedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, len(m.nodes))
+	m.Range(func(k K, _ V) bool { keys = append(keys, k); return true })
+	return keys
+}
+
+// Values returns all the values in order.
+func (m *OrderedMap[K, V]) Values() []V {
+	values := make([]V, 0, len(m.nodes))
+	m.Range(func(_ K, v V) bool { values = append(values, v); return true })
+	return values
+}
+
+// Clone returns a copy of the ordered map.
+func (m *OrderedMap[K, V]) Clone() *OrderedMap[K, V] {
+	c := NewOrderedMap[K, V](len(m.nodes))
+	m.Range(func(k K, v V) bool { c.Set(k, v); return true })
+	return c
+}
+
+// Map converts the ordered map to a builtin map.
+func (m *OrderedMap[K, V]) Map() map[K]V {
+	maps := make(map[K]V, len(m.nodes))
+	m.Range(func(k K, v V) bool { maps[k] = v; return true })
+	return maps
+}
+
+//////////////////////////////////////////////////////////////////////////////
+
+var (
+	_ json.Marshaler   = new(OrderedMap[string, any])
+	_ json.Unmarshaler = new(OrderedMap[string, any])
+)
+
+// MarshalJSON implements the interface json.Marshaler,
+// which encodes the map as a JSON object in order.
+//
+// The key must be a string, an integer or an encoding.TextMarshaler.
+func (m *OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return []byte("null"), nil
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, 64))
+	buf.WriteByte('{')
+
+	var err error
+	m.Range(func(k K, v V) bool {
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+
+		var key string
+		if key, err = encodeKey(k); err != nil {
+			return false
+		}
+
+		var data []byte
+		if data, err = json.Marshal(key); err != nil {
+			return false
+		}
+		buf.Write(data)
+		buf.WriteByte(':')
+
+		if data, err = json.Marshal(v); err != nil {
+			return false
+		}
+		buf.Write(data)
+		return true
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements the interface json.Unmarshaler,
+// which decodes a JSON object in order and replaces all the key-value pairs.
+//
+// If V is an interface type, such as any, the nested JSON objects
+// are decoded as *OrderedMap[string, any] to keep their order, too.
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	m.init()
+	m.Clear()
+	if token == nil { // null
+		return nil
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("mapx.OrderedMap: cannot unmarshal %v into an object", token)
+	}
+
+	return m.decodeObject(dec)
+}
+
+func (m *OrderedMap[K, V]) decodeObject(dec *json.Decoder) error {
+	isany := reflect.TypeOf((*V)(nil)).Elem().Kind() == reflect.Interface
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		k, err := decodeKey[K](token.(string))
+		if err != nil {
+			return err
+		}
+
+		var v V
+		if isany {
+			var value any
+			if value, err = decodeAny(dec); err != nil {
+				return err
+			}
+
+			var ok bool
+			if v, ok = value.(V); !ok && value != nil {
+				return fmt.Errorf("mapx.OrderedMap: cannot unmarshal %T into %T", value, v)
+			}
+		} else if err = dec.Decode(&v); err != nil {
+			return err
+		}
+
+		m.Set(k, v)
+	}
+
+	_, err := dec.Token() // '}'
+	return err
+}
+
+func decodeAny(dec *json.Decoder) (any, error) {
+	token, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := token.(type) {
+	case json.Delim:
+		switch v {
+		case '{':
+			m := NewOrderedMap[string, any](0)
+			return m, m.decodeObject(dec)
+
+		case '[':
+			values := []any{}
+			for dec.More() {
+				value, err := decodeAny(dec)
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, value)
+			}
+			_, err = dec.Token() // ']'
+			return values, err
+
+		default:
+			return nil, fmt.Errorf("mapx.OrderedMap: unexpected delimiter %v", v)
+		}
+
+	default:
+		return v, nil
+	}
+}
+
+func encodeKey[K comparable](k K) (string, error) {
+	if m, ok := any(k).(encoding.TextMarshaler); ok {
+		data, err := m.MarshalText()
+		return string(data), err
+	}
+
+	v := reflect.ValueOf(k)
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	default:
+		return "", fmt.Errorf("mapx.OrderedMap: unsupported key type %T", k)
+	}
+}
+
+func decodeKey[K comparable](s string) (k K, err error) {
+	if u, ok := any(&k).(encoding.TextUnmarshaler); ok {
+		err = u.UnmarshalText([]byte(s))
+		return
+	}
+
+	v := reflect.ValueOf(&k).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		if i, err = strconv.ParseInt(s, 10, v.Type().Bits()); err == nil {
+			v.SetInt(i)
+		}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var i uint64
+		if i, err = strconv.ParseUint(s, 10, v.Type().Bits()); err == nil {
+			v.SetUint(i)
+		}
+
+	default:
+		err = fmt.Errorf("mapx.OrderedMap: unsupported key type %T", k)
+	}
+
+	return
+}
@@ -0,0 +1,176 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func ExampleOrderedMap() {
+	m := NewOrderedMap[string, int](4)
+	m.Set("c", 1)
+	m.Set("a", 2)
+	m.Set("b", 3)
+	m.Set("c", 4)
+	m.MoveToEnd("a")
+
+	fmt.Println(m.Keys())
+	fmt.Println(m.Values())
+
+	data, _ := json.Marshal(m)
+	fmt.Println(string(data))
+
+	// Output:
+	// [c b a]
+	// [4 3 2]
+	// {"c":4,"b":3,"a":2}
+}
+
+func TestOrderedMap(t *testing.T) {
+	var m OrderedMap[int, string]
+	if _, _, ok := m.First(); ok {
+		t.Errorf("unexpect the first element of the empty map")
+	}
+
+	for i := 0; i < 5; i++ {
+		m.Set(i, fmt.Sprint(i))
+	}
+	m.MoveToFront(4)
+	m.MoveToEnd(0)
+	if v, ok := m.Delete(2); !ok || v != "2" {
+		t.Errorf("expect to delete '%s', but got '%s'", "2", v)
+	}
+	if _, ok := m.Delete(2); ok {
+		t.Errorf("unexpect to delete the deleted key")
+	}
+
+	if keys := m.Keys(); !slices.Equal(keys, []int{4, 1, 3, 0}) {
+		t.Errorf("expect %v, but got %v", []int{4, 1, 3, 0}, keys)
+	}
+
+	var keys []int
+	m.RangeReverse(func(k int, _ string) bool { keys = append(keys, k); return k != 1 })
+	if !slices.Equal(keys, []int{0, 3, 1}) {
+		t.Errorf("expect %v, but got %v", []int{0, 3, 1}, keys)
+	}
+
+	if k, v, ok := m.Last(); !ok || k != 0 || v != "0" {
+		t.Errorf("expect the last %v, but got %v", 0, k)
+	}
+
+	data, err := json.Marshal(&m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := string(data); s != `{"4":"4","1":"1","3":"3","0":"0"}` {
+		t.Errorf("unexpected json %s", s)
+	}
+
+	var n OrderedMap[int, string]
+	if err := json.Unmarshal(data, &n); err != nil {
+		t.Fatal(err)
+	} else if keys := n.Keys(); !slices.Equal(keys, []int{4, 1, 3, 0}) {
+		t.Errorf("expect %v, but got %v", []int{4, 1, 3, 0}, keys)
+	}
+
+	if err := json.Unmarshal([]byte(`{"a":"b"}`), &n); err == nil {
+		t.Errorf("expect an error for the invalid key")
+	}
+
+	m.Clear()
+	if m.Len() != 0 || len(m.Keys()) != 0 {
+		t.Errorf("expect the empty map")
+	}
+}
+
+func TestOrderedMapRangeDelete(t *testing.T) {
+	newmap := func() *OrderedMap[int, int] {
+		m := NewOrderedMap[int, int](8)
+		for i := 0; i < 8; i++ {
+			m.Set(i, i)
+		}
+		return m
+	}
+
+	tests := []struct {
+		name    string
+		reverse bool
+		f       func(m *OrderedMap[int, int], k int)
+		visited []int
+		keys    []int
+	}{
+		{"current", false, func(m *OrderedMap[int, int], k int) { m.Delete(k) }, []int{0, 1, 2, 3, 4, 5, 6, 7}, []int{}},
+		{"next", false, func(m *OrderedMap[int, int], k int) { m.Delete(k + 1) }, []int{0, 2, 4, 6}, []int{0, 2, 4, 6}},
+		{"current and next", false, func(m *OrderedMap[int, int], k int) { m.Delete(k); m.Delete(k + 1) }, []int{0, 2, 4, 6}, []int{}},
+		{"previous", false, func(m *OrderedMap[int, int], k int) { m.Delete(k - 1) }, []int{0, 1, 2, 3, 4, 5, 6, 7}, []int{7}},
+		{"clear", false, func(m *OrderedMap[int, int], k int) { m.Clear() }, []int{0}, []int{}},
+		{"reverse next", true, func(m *OrderedMap[int, int], k int) { m.Delete(k - 1) }, []int{7, 5, 3, 1}, []int{1, 3, 5, 7}},
+		{"reverse current and next", true, func(m *OrderedMap[int, int], k int) { m.Delete(k); m.Delete(k - 1) }, []int{7, 5, 3, 1}, []int{}},
+	}
+
+	for _, test := range tests {
+		m := newmap()
+		visited := []int{}
+		f := func(k, _ int) bool {
+			visited = append(visited, k)
+			test.f(m, k)
+			return true
+		}
+
+		if test.reverse {
+			m.RangeReverse(f)
+		} else {
+			m.Range(f)
+		}
+
+		if !slices.Equal(visited, test.visited) {
+			t.Errorf("%s: expect to visit %v, but got %v", test.name, test.visited, visited)
+		}
+		if keys := m.Keys(); !slices.Equal(keys, test.keys) {
+			t.Errorf("%s: expect the keys %v, but got %v", test.name, test.keys, keys)
+		}
+	}
+}
+
+func TestOrderedMapNestedJSON(t *testing.T) {
+	const data = `{"z":1,"y":{"b":true,"a":[{"d":null,"c":"x"}]},"x":[1,2]}`
+
+	m := NewOrderedMap[string, any](0)
+	if err := json.Unmarshal([]byte(data), m); err != nil {
+		t.Fatal(err)
+	}
+
+	y, _ := m.Get("y")
+	nested, ok := y.(*OrderedMap[string, any])
+	if !ok {
+		t.Fatalf("expect a nested ordered map, but got %T", y)
+	}
+	if keys := nested.Keys(); !slices.Equal(keys, []string{"b", "a"}) {
+		t.Errorf("expect %v, but got %v", []string{"b", "a"}, keys)
+	}
+	if z, _ := m.Get("z"); z != float64(1) {
+		t.Errorf("expect %v, but got %v", 1, z)
+	}
+
+	out, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	} else if s := string(out); s != data {
+		t.Errorf("expect %s, but got %s", data, s)
+	}
+}
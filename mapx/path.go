@@ -0,0 +1,452 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ErrPathNotFound is returned when the path does not exist.
+var ErrPathNotFound = errors.New("path not found")
+
+// PathError is the error about the path access.
+type PathError struct {
+	Path string // The full path.
+	At   string // The prefix of the path where the error occurs.
+	Err  error
+}
+
+// Error implements the interface error.
+func (e *PathError) Error() string {
+	if e.At == "" || e.At == e.Path {
+		return fmt.Sprintf("mapx: path '%s': %s", e.Path, e.Err)
+	}
+	return fmt.Sprintf("mapx: path '%s' at '%s': %s", e.Path, e.At, e.Err)
+}
+
+// Unwrap returns the inner error.
+func (e *PathError) Unwrap() error { return e.Err }
+
+type pathAccessor struct {
+	path   string
+	segs   []string
+	prefix func(n int) string
+}
+
+// parsePath parses the dotted path, such as "a.b.0.c",
+// or the JSON Pointer defined by RFC 6901, such as "/a/b/0/c".
+func parsePath(path string) pathAccessor {
+	if path == "" {
+		return pathAccessor{path: path, prefix: func(int) string { return "" }}
+	}
+
+	if strings.HasPrefix(path, "/") {
+		segs := strings.Split(path[1:], "/")
+		for i, seg := range segs {
+			if strings.IndexByte(seg, '~') > -1 {
+				segs[i] = strings.ReplaceAll(strings.ReplaceAll(seg, "~1", "/"), "~0", "~")
+			}
+		}
+
+		parts := strings.Split(path, "/")
+		return pathAccessor{path: path, segs: segs,
+			prefix: func(n int) string { return strings.Join(parts[:n+1], "/") }}
+	}
+
+	segs := strings.Split(path, ".")
+	return pathAccessor{path: path, segs: segs,
+		prefix: func(n int) string { return strings.Join(segs[:n], ".") }}
+}
+
+func (p pathAccessor) error(n int, format string, args ...any) error {
+	return &PathError{Path: p.path, At: p.prefix(n), Err: fmt.Errorf(format, args...)}
+}
+
+func (p pathAccessor) notFound(n int) error {
+	return &PathError{Path: p.path, At: p.prefix(n), Err: ErrPathNotFound}
+}
+
+func parseIndex(seg string, _len int, allowEnd bool) (int, bool) {
+	if seg == "-" && allowEnd {
+		return _len, true
+	}
+
+	// RFC 6901: array-index = %x30 / ( %x31-39 *(%x30-39) )
+	if seg == "" || seg[0] < '0' || seg[0] > '9' || (seg != "0" && seg[0] == '0') {
+		return 0, false
+	}
+
+	i, err := strconv.Atoi(seg)
+	if err != nil {
+		return 0, false
+	}
+	if i > _len || (i == _len && !allowEnd) {
+		return 0, false
+	}
+	return i, true
+}
+
+func (p pathAccessor) get(node any) (any, error) {
+	for i, seg := range p.segs {
+		switch n := node.(type) {
+		case map[string]any:
+			v, ok := n[seg]
+			if !ok {
+				return nil, p.notFound(i + 1)
+			}
+			node = v
+
+		case *OrderedMap[string, any]:
+			v, ok := n.Get(seg)
+			if !ok {
+				return nil, p.notFound(i + 1)
+			}
+			node = v
+
+		case []any:
+			index, ok := parseIndex(seg, len(n), false)
+			if !ok {
+				if _, err := strconv.Atoi(seg); err == nil {
+					return nil, p.notFound(i + 1)
+				}
+				return nil, p.error(i+1, "invalid array index '%s'", seg)
+			}
+			node = n[index]
+
+		default:
+			return nil, p.error(i, "expect an object or array, but got %T", node)
+		}
+	}
+	return node, nil
+}
+
+func (p pathAccessor) set(node any, i int, value any) (any, error) {
+	if i == len(p.segs) {
+		return value, nil
+	}
+
+	seg := p.segs[i]
+	switch n := node.(type) {
+	case nil: // Create the intermediate map.
+		child, err := p.set(nil, i+1, value)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{seg: child}, nil
+
+	case map[string]any:
+		child, err := p.set(n[seg], i+1, value)
+		if err != nil {
+			return nil, err
+		}
+		n[seg] = child
+		return n, nil
+
+	case *OrderedMap[string, any]:
+		old, _ := n.Get(seg)
+		child, err := p.set(old, i+1, value)
+		if err != nil {
+			return nil, err
+		}
+		n.Set(seg, child)
+		return n, nil
+
+	case []any:
+		index, ok := parseIndex(seg, len(n), true)
+		if !ok {
+			return nil, p.error(i+1, "invalid array index '%s' for length %d", seg, len(n))
+		}
+
+		var old any
+		if index < len(n) {
+			old = n[index]
+		}
+
+		child, err := p.set(old, i+1, value)
+		if err != nil {
+			return nil, err
+		}
+
+		if index == len(n) {
+			return append(n, child), nil
+		}
+		n[index] = child
+		return n, nil
+
+	default:
+		return nil, p.error(i, "expect an object or array, but got %T", node)
+	}
+}
+
+func (p pathAccessor) delete(node any, i int) (any, error) {
+	seg := p.segs[i]
+	last := i == len(p.segs)-1
+
+	switch n := node.(type) {
+	case map[string]any:
+		child, ok := n[seg]
+		if !ok {
+			return nil, p.notFound(i + 1)
+		}
+
+		if last {
+			delete(n, seg)
+		} else if child, err := p.delete(child, i+1); err != nil {
+			return nil, err
+		} else {
+			n[seg] = child
+		}
+		return n, nil
+
+	case *OrderedMap[string, any]:
+		child, ok := n.Get(seg)
+		if !ok {
+			return nil, p.notFound(i + 1)
+		}
+
+		if last {
+			n.Delete(seg)
+		} else if child, err := p.delete(child, i+1); err != nil {
+			return nil, err
+		} else {
+			n.Set(seg, child)
+		}
+		return n, nil
+
+	case []any:
+		index, ok := parseIndex(seg, len(n), false)
+		if !ok {
+			return nil, p.notFound(i + 1)
+		}
+
+		if last {
+			return append(n[:index], n[index+1:]...), nil
+		}
+
+		child, err := p.delete(n[index], i+1)
+		if err != nil {
+			return nil, err
+		}
+		n[index] = child
+		return n, nil
+
+	default:
+		return nil, p.error(i, "expect an object or array, but got %T", node)
+	}
+}
+
+// GetPath returns the value of the path in the nested map.
+//
+// The path may be a dotted path, such as "db.pool.size" and "servers.0.host",
+// or a JSON Pointer defined by RFC 6901, such as "/db/pool/size" and
+// "/servers/0/host". The empty path means the map itself.
+//
+// The intermediate values must be map[string]any, *OrderedMap[string, any]
+// or []any, the element of which is accessed by the index.
+//
+// If the path does not exist, return a *PathError wrapping ErrPathNotFound.
+func GetPath(m map[string]any, path string) (any, error) {
+	return parsePath(path).get(m)
+}
+
+// GetPathAs is the same as GetPath, but asserts the value to T.
+//
+// If T is a number type and the value is a number, such as float64 decoded
+// from JSON, it is converted to T if no precision is lost.
+func GetPathAs[T any](m map[string]any, path string) (value T, err error) {
+	v, err := GetPath(m, path)
+	if err != nil {
+		return
+	}
+
+	if t, ok := v.(T); ok {
+		return t, nil
+	} else if v == nil && canBeNil(reflect.TypeOf((*T)(nil)).Elem()) {
+		return // JSON null
+	}
+
+	if ok := convertNumber(v, &value); !ok {
+		err = &PathError{Path: path, At: path, Err: fmt.Errorf("expect type %s, but got %T",
+			reflect.TypeOf((*T)(nil)).Elem(), v)}
+	}
+	return
+}
+
+func canBeNil(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return true
+	default:
+		return false
+	}
+}
+
+// Both 2^63 and 2^64 are exact in float64.
+const (
+	twoPow63 = float64(1 << 63)
+	twoPow64 = twoPow63 * 2
+)
+
+func convertNumber(src, dst any) bool {
+	sv := reflect.ValueOf(src)
+	dv := reflect.ValueOf(dst).Elem()
+
+	switch sv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return convertInt(sv.Int(), dv)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return convertUint(sv.Uint(), dv)
+	case reflect.Float32, reflect.Float64:
+		return convertFloat(sv.Float(), dv)
+	default:
+		return false
+	}
+}
+
+func convertInt(i int64, dv reflect.Value) bool {
+	switch dv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if dv.OverflowInt(i) {
+			return false
+		}
+		dv.SetInt(i)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if i < 0 || dv.OverflowUint(uint64(i)) {
+			return false
+		}
+		dv.SetUint(uint64(i))
+
+	case reflect.Float32, reflect.Float64:
+		f := float64(i)
+		if f >= twoPow63 || int64(f) != i || !setFloat(f, dv) {
+			return false
+		}
+
+	default:
+		return false
+	}
+
+	return true
+}
+
+func convertUint(u uint64, dv reflect.Value) bool {
+	switch dv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if u > math.MaxInt64 || dv.OverflowInt(int64(u)) {
+			return false
+		}
+		dv.SetInt(int64(u))
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if dv.OverflowUint(u) {
+			return false
+		}
+		dv.SetUint(u)
+
+	case reflect.Float32, reflect.Float64:
+		f := float64(u)
+		if f >= twoPow64 || uint64(f) != u || !setFloat(f, dv) {
+			return false
+		}
+
+	default:
+		return false
+	}
+
+	return true
+}
+
+func convertFloat(f float64, dv reflect.Value) bool {
+	switch dv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		// The range check must be done before converting f to int64,
+		// because the out-of-range conversion is implementation-defined.
+		if f != math.Trunc(f) || f < -twoPow63 || f >= twoPow63 || dv.OverflowInt(int64(f)) {
+			return false
+		}
+		dv.SetInt(int64(f))
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if f != math.Trunc(f) || f < 0 || f >= twoPow64 || dv.OverflowUint(uint64(f)) {
+			return false
+		}
+		dv.SetUint(uint64(f))
+
+	case reflect.Float32, reflect.Float64:
+		return setFloat(f, dv)
+
+	default:
+		return false
+	}
+
+	return true
+}
+
+// setFloat sets f into dv if it is exact in dv.
+func setFloat(f float64, dv reflect.Value) bool {
+	if dv.Kind() == reflect.Float32 && !math.IsNaN(f) && float64(float32(f)) != f {
+		return false
+	}
+	dv.SetFloat(f)
+	return true
+}
+
+// HasPath reports whether the path exists in the nested map.
+//
+// For the path, see GetPath.
+func HasPath(m map[string]any, path string) bool {
+	_, err := GetPath(m, path)
+	return err == nil
+}
+
+// SetPath sets the value of the path in the nested map,
+// which creates the intermediate maps if they do not exist.
+//
+// For the array, the index must be in [0, len], or "-" like JSON Pointer,
+// and the index len or "-" means to append the value.
+// For the path, see GetPath.
+func SetPath(m map[string]any, path string, value any) error {
+	p := parsePath(path)
+	if len(p.segs) == 0 {
+		return p.error(0, "cannot set the root")
+	}
+	if m == nil {
+		return p.error(0, "the map is nil")
+	}
+
+	_, err := p.set(m, 0, value)
+	return err
+}
+
+// DeletePath deletes the value of the path in the nested map.
+// For the array, the element is removed and the rest are shifted.
+//
+// If the path does not exist, return a *PathError wrapping ErrPathNotFound.
+// For the path, see GetPath.
+func DeletePath(m map[string]any, path string) error {
+	p := parsePath(path)
+	if len(p.segs) == 0 {
+		return p.error(0, "cannot delete the root")
+	}
+
+	_, err := p.delete(m, 0)
+	return err
+}
@@ -0,0 +1,175 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+func ExampleGetPathAs() {
+	cfg := map[string]any{
+		"db": map[string]any{
+			"pool":    map[string]any{"size": float64(10)},
+			"servers": []any{map[string]any{"host": "a"}, map[string]any{"host": "b"}},
+		},
+	}
+
+	size, _ := GetPathAs[int](cfg, "db.pool.size")
+	host, _ := GetPathAs[string](cfg, "/db/servers/1/host")
+	fmt.Println(size, host)
+
+	_, err := GetPathAs[string](cfg, "db.pool.size")
+	fmt.Println(err)
+
+	_, err = GetPath(cfg, "db.pool.size.x")
+	fmt.Println(err)
+
+	_, err = GetPath(cfg, "db.timeout")
+	fmt.Println(err)
+
+	// Output:
+	// 10 b
+	// mapx: path 'db.pool.size': expect type string, but got float64
+	// mapx: path 'db.pool.size.x' at 'db.pool.size': expect an object or array, but got float64
+	// mapx: path 'db.timeout': path not found
+}
+
+func TestSetPath(t *testing.T) {
+	m := map[string]any{"a/b": map[string]any{"m~n": 1}, "list": []any{1}}
+
+	if v, err := GetPath(m, "/a~1b/m~0n"); err != nil || v != 1 {
+		t.Errorf("expect %v, but got %v: %v", 1, v, err)
+	}
+	if v, err := GetPath(m, ""); err != nil || v == nil {
+		t.Errorf("expect the root, but got %v: %v", v, err)
+	}
+
+	if err := SetPath(m, "x.y.z", "v"); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := GetPathAs[string](m, "x.y.z"); v != "v" {
+		t.Errorf("expect '%s', but got '%v'", "v", v)
+	}
+
+	if err := SetPath(m, "/list/-", 2); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetPath(m, "list.2.k", 3); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetPath(m, "list.0", 0); err != nil {
+		t.Fatal(err)
+	}
+	if s := fmt.Sprint(m["list"]); s != "[0 2 map[k:3]]" {
+		t.Errorf("unexpected list %s", s)
+	}
+	if err := SetPath(m, "list.5", 5); err == nil {
+		t.Errorf("expect an error for the index out of range")
+	}
+	if err := SetPath(m, "x.y.z.w", 5); err == nil {
+		t.Errorf("expect an error for setting in a string")
+	}
+
+	if !HasPath(m, "list.2.k") || HasPath(m, "list.3") || HasPath(m, "list.a") {
+		t.Errorf("unexpected HasPath result")
+	}
+	for _, path := range []string{"list.+1", "list.-0", "/list/+1", "/list/-0", "list.01", "list.1e0"} {
+		if HasPath(m, path) {
+			t.Errorf("expect the invalid index in '%s'", path)
+		}
+	}
+	if err := SetPath(m, "list.+3", 0); err == nil {
+		t.Errorf("expect an error for the signed index")
+	}
+
+	if err := DeletePath(m, "list.1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := DeletePath(m, "/x/y"); err != nil {
+		t.Fatal(err)
+	}
+	if err := DeletePath(m, "x.y"); !errors.Is(err, ErrPathNotFound) {
+		t.Errorf("expect ErrPathNotFound, but got %v", err)
+	}
+	if s := fmt.Sprint(m["list"], m["x"]); s != "[0 map[k:3]] map[]" {
+		t.Errorf("unexpected result %s", s)
+	}
+
+	om := NewOrderedMap[string, any](0)
+	m["om"] = om
+	if err := SetPath(m, "om.a.b", true); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := GetPathAs[bool](m, "om.a.b"); !v {
+		t.Errorf("expect to set the value in the ordered map")
+	}
+
+	if _, err := GetPathAs[uint8](map[string]any{"a": 256.0}, "a"); err == nil {
+		t.Errorf("expect an error for the overflow")
+	}
+	if _, err := GetPathAs[int](map[string]any{"a": 1.5}, "a"); err == nil {
+		t.Errorf("expect an error for the precision loss")
+	}
+
+	for _, v := range []any{1e19, -1e19, uint64(math.MaxUint64), math.Inf(1), math.NaN()} {
+		if i, err := GetPathAs[int64](map[string]any{"a": v}, "a"); err == nil {
+			t.Errorf("expect an error for the overflow of %v, but got %d", v, i)
+		}
+	}
+	if _, err := GetPathAs[uint64](map[string]any{"a": 2e19}, "a"); err == nil {
+		t.Errorf("expect an error for the overflow of 2e19")
+	}
+	if v, err := GetPathAs[int64](map[string]any{"a": uint64(1<<53 + 1)}, "a"); err != nil || v != 1<<53+1 {
+		t.Errorf("expect %d, but got %d: %v", 1<<53+1, v, err)
+	}
+	if v, err := GetPathAs[uint64](map[string]any{"a": int64(math.MaxInt64)}, "a"); err != nil || v != math.MaxInt64 {
+		t.Errorf("expect %d, but got %d: %v", int64(math.MaxInt64), v, err)
+	}
+	if _, err := GetPathAs[float32](map[string]any{"a": 0.1}, "a"); err == nil {
+		t.Errorf("expect an error for the precision loss of 0.1 to float32")
+	}
+	if v, err := GetPathAs[float32](map[string]any{"a": 0.5}, "a"); err != nil || v != 0.5 {
+		t.Errorf("expect 0.5, but got %v: %v", v, err)
+	}
+	if _, err := GetPathAs[float64](map[string]any{"a": int64(1<<53 + 1)}, "a"); err == nil {
+		t.Errorf("expect an error for the precision loss of 2^53+1")
+	}
+}
+
+func TestGetPathAsNull(t *testing.T) {
+	m := map[string]any{"a": nil}
+
+	if v, err := GetPathAs[any](m, "a"); err != nil || v != nil {
+		t.Errorf("any: expect nil, but got %v: %v", v, err)
+	}
+	if v, err := GetPathAs[map[string]any](m, "a"); err != nil || v != nil {
+		t.Errorf("map: expect nil, but got %v: %v", v, err)
+	}
+	if v, err := GetPathAs[[]any](m, "a"); err != nil || v != nil {
+		t.Errorf("slice: expect nil, but got %v: %v", v, err)
+	}
+	if v, err := GetPathAs[*int](m, "a"); err != nil || v != nil {
+		t.Errorf("pointer: expect nil, but got %v: %v", v, err)
+	}
+	if _, err := GetPathAs[string](m, "a"); err == nil {
+		t.Errorf("string: expect an error for null")
+	} else if s := err.Error(); !strings.Contains(s, "expect type string, but got <nil>") {
+		t.Errorf("unexpected error: %s", s)
+	}
+}
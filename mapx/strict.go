@@ -0,0 +1,224 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrDuplicateKey is wrapped by DuplicateKeyError.
+var ErrDuplicateKey = errors.New("duplicate key")
+
+// DuplicateKeyError is returned when more than one element
+// are converted to the same key, or more than one map have the same key.
+type DuplicateKeyError[K comparable] struct {
+	// For the slices, it is in the order of the first duplication.
+	// For the maps, it is sorted by the natural order or the formatted strings.
+	Keys []K
+}
+
+// Error implements the interface error.
+func (e *DuplicateKeyError[K]) Error() string {
+	var b strings.Builder
+	b.WriteString("mapx: duplicate keys:")
+	for _, k := range e.Keys {
+		fmt.Fprintf(&b, " %v", k)
+	}
+	return b.String()
+}
+
+// Unwrap returns ErrDuplicateKey.
+func (e *DuplicateKeyError[K]) Unwrap() error { return ErrDuplicateKey }
+
+// duplicates collects the duplicate keys in order.
+type duplicates[K comparable] struct {
+	keys []K
+	seen map[K]bool // true means the key has been recorded as duplicate.
+}
+
+func (d *duplicates[K]) add(k K, exist bool) {
+	if recorded, ok := d.seen[k]; ok || exist {
+		if !recorded {
+			d.keys = append(d.keys, k)
+		}
+		d.seen[k] = true
+	} else {
+		d.seen[k] = false
+	}
+}
+
+func (d *duplicates[K]) err() error {
+	if len(d.keys) == 0 {
+		return nil
+	}
+	return &DuplicateKeyError[K]{Keys: d.keys}
+}
+
+//////////////////////////////////////////////////////////////////////////////
+
+// FromSliceWithIndexStrict is the same as FromSliceWithIndex, but returns
+// a *DuplicateKeyError with all the duplicate keys if more than one element
+// are converted to the same key.
+func FromSliceWithIndexStrict[S ~[]E, K comparable, V, E any](s S, convert func(int, E) (K, V)) (map[K]V, error) {
+	_len := len(s)
+	maps := make(map[K]V, _len)
+	dups := duplicates[K]{seen: make(map[K]bool, _len)}
+	for i := 0; i < _len; i++ {
+		k, v := convert(i, s[i])
+		_, exist := maps[k]
+		dups.add(k, exist)
+		maps[k] = v
+	}
+
+	if err := dups.err(); err != nil {
+		return nil, err
+	}
+	return maps, nil
+}
+
+// FromSliceStrict is the same as FromSlice, but returns a *DuplicateKeyError
+// with all the duplicate keys if more than one element are converted
+// to the same key. See also IndexBy.
+func FromSliceStrict[S ~[]E, K comparable, V, E any](s S, convert func(E) (K, V)) (map[K]V, error) {
+	return FromSliceWithIndexStrict(s, func(_ int, e E) (K, V) { return convert(e) })
+}
+
+// FromSliceWithIndexFirst is the same as FromSliceWithIndex,
+// but the first element wins if more than one element are converted
+// to the same key.
+func FromSliceWithIndexFirst[S ~[]E, K comparable, V, E any](s S, convert func(int, E) (K, V)) map[K]V {
+	_len := len(s)
+	maps := make(map[K]V, _len)
+	for i := 0; i < _len; i++ {
+		k, v := convert(i, s[i])
+		TryAdd(maps, k, v)
+	}
+	return maps
+}
+
+// FromSliceFirst is the same as FromSlice, but the first element wins
+// if more than one element are converted to the same key.
+func FromSliceFirst[S ~[]E, K comparable, V, E any](s S, convert func(E) (K, V)) map[K]V {
+	return FromSliceWithIndexFirst(s, func(_ int, e E) (K, V) { return convert(e) })
+}
+
+// FromSliceWithIndexAll is the same as FromSliceWithIndex, but collects
+// all the values of the same key in order.
+func FromSliceWithIndexAll[S ~[]E, K comparable, V, E any](s S, convert func(int, E) (K, V)) map[K][]V {
+	_len := len(s)
+	maps := make(map[K][]V, _len)
+	for i := 0; i < _len; i++ {
+		k, v := convert(i, s[i])
+		maps[k] = append(maps[k], v)
+	}
+	return maps
+}
+
+// FromSliceAll is the same as FromSlice, but collects all the values
+// of the same key in order. See also GroupBy and GroupByFunc.
+func FromSliceAll[S ~[]E, K comparable, V, E any](s S, convert func(E) (K, V)) map[K][]V {
+	return FromSliceWithIndexAll(s, func(_ int, e E) (K, V) { return convert(e) })
+}
+
+//////////////////////////////////////////////////////////////////////////////
+
+// AddSliceStrict is the same as AddSlice, but returns a *DuplicateKeyError
+// with all the duplicate keys if more than one element are converted to
+// the same key or the key has existed in maps. If so, maps is not modified.
+func AddSliceStrict[M ~map[K]V, S ~[]E, K comparable, V, E any](maps M, slices S, convert func(E) (K, V)) error {
+	keys := make([]K, len(slices))
+	values := make([]V, len(slices))
+	dups := duplicates[K]{seen: make(map[K]bool, len(slices))}
+	for i, e := range slices {
+		keys[i], values[i] = convert(e)
+		_, exist := maps[keys[i]]
+		dups.add(keys[i], exist)
+	}
+
+	if err := dups.err(); err != nil {
+		return err
+	}
+
+	for i, k := range keys {
+		maps[k] = values[i]
+	}
+	return nil
+}
+
+// AddSliceFirst is the same as AddSlice, but the existing key and
+// the first element win if more than one element are converted to the same key.
+func AddSliceFirst[M ~map[K]V, S ~[]E, K comparable, V, E any](maps M, slices S, convert func(E) (K, V)) {
+	for _, e := range slices {
+		k, v := convert(e)
+		TryAdd(maps, k, v)
+	}
+}
+
+// AddSliceAll is the same as AddSlice, but appends all the values
+// of the same key in order.
+func AddSliceAll[M ~map[K][]V, S ~[]E, K comparable, V, E any](maps M, slices S, convert func(E) (K, V)) {
+	for _, e := range slices {
+		k, v := convert(e)
+		maps[k] = append(maps[k], v)
+	}
+}
+
+//////////////////////////////////////////////////////////////////////////////
+
+// SetMapFuncStrict is the same as SetMapFunc, but returns a *DuplicateKeyError
+// with all the duplicate keys if more than one element are converted
+// to the same key.
+func SetMapFuncStrict[S ~[]T, K comparable, T any](s S, convert func(T) K) (map[K]struct{}, error) {
+	return FromSliceStrict(s, func(e T) (K, struct{}) { return convert(e), struct{}{} })
+}
+
+// BoolMapFuncStrict is the same as BoolMapFunc, but returns a *DuplicateKeyError
+// with all the duplicate keys if more than one element are converted
+// to the same key.
+func BoolMapFuncStrict[S ~[]T, K comparable, T any](s S, convert func(T) K) (map[K]bool, error) {
+	return FromSliceStrict(s, func(e T) (K, bool) { return convert(e), true })
+}
+
+// SetMapFuncFirst is the same as SetMapFunc, but the first element wins
+// if more than one element are converted to the same key.
+//
+// Because all the values are the same, it only keeps consistent
+// with the variants of FromSlice and AddSlice.
+func SetMapFuncFirst[S ~[]T, K comparable, T any](s S, convert func(T) K) map[K]struct{} {
+	return FromSliceFirst(s, func(e T) (K, struct{}) { return convert(e), struct{}{} })
+}
+
+// SetMapFuncAll is the same as SetMapFunc, but collects all the elements
+// converted to the same key in order.
+func SetMapFuncAll[S ~[]T, K comparable, T any](s S, convert func(T) K) map[K][]T {
+	return FromSliceAll(s, func(e T) (K, T) { return convert(e), e })
+}
+
+// BoolMapFuncFirst is the same as BoolMapFunc, but the first element wins
+// if more than one element are converted to the same key.
+//
+// Because all the values are the same, it only keeps consistent
+// with the variants of FromSlice and AddSlice.
+func BoolMapFuncFirst[S ~[]T, K comparable, T any](s S, convert func(T) K) map[K]bool {
+	return FromSliceFirst(s, func(e T) (K, bool) { return convert(e), true })
+}
+
+// BoolMapFuncAll is the same as BoolMapFunc, but collects all the elements
+// converted to the same key in order.
+func BoolMapFuncAll[S ~[]T, K comparable, T any](s S, convert func(T) K) map[K][]T {
+	return FromSliceAll(s, func(e T) (K, T) { return convert(e), e })
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleFromSliceStrict() {
+	type User struct {
+		ID   int
+		Name string
+	}
+
+	users := []User{{1, "a"}, {2, "b"}, {1, "c"}, {3, "d"}, {2, "e"}, {1, "f"}}
+	convert := func(u User) (int, string) { return u.ID, u.Name }
+
+	_, err := FromSliceStrict(users, convert)
+	fmt.Println(err)
+	fmt.Println(FromSliceFirst(users, convert))
+	fmt.Println(FromSliceAll(users, convert))
+
+	// Output:
+	// mapx: duplicate keys: 1 2
+	// map[1:a 2:b 3:d]
+	// map[1:[a c f] 2:[b e] 3:[d]]
+}
+
+func TestAddSliceStrict(t *testing.T) {
+	convert := func(s string) (string, int) { return s, len(s) }
+
+	maps := map[string]int{"a": 1}
+	err := AddSliceStrict(maps, []string{"b", "a"}, convert)
+	if !errors.Is(err, ErrDuplicateKey) {
+		t.Errorf("expect ErrDuplicateKey, but got %v", err)
+	} else if len(maps) != 1 {
+		t.Errorf("expect maps not to be modified, but got %v", maps)
+	}
+
+	if err := AddSliceStrict(maps, []string{"b", "cc"}, convert); err != nil || len(maps) != 3 {
+		t.Errorf("unexpected result %v: %v", maps, err)
+	}
+
+	AddSliceFirst(maps, []string{"dd", "a"}, func(s string) (string, int) { return s[:1], 0 })
+	if s := fmt.Sprint(maps); s != "map[a:1 b:1 cc:2 d:0]" {
+		t.Errorf("unexpected map %s", s)
+	}
+
+	all := map[int][]string{1: {"x"}}
+	AddSliceAll(all, []string{"a", "bb", "c"}, func(s string) (int, string) { return len(s), s })
+	if s := fmt.Sprint(all); s != "map[1:[x a c] 2:[bb]]" {
+		t.Errorf("unexpected map %s", s)
+	}
+
+	_, err = SetMapFuncStrict([]string{"a", "b", "a"}, func(s string) string { return s })
+	var derr *DuplicateKeyError[string]
+	if !errors.As(err, &derr) || len(derr.Keys) != 1 || derr.Keys[0] != "a" {
+		t.Errorf("expect the duplicate key 'a', but got %v", err)
+	}
+
+	if _, err := BoolMapFuncStrict([]string{"a", "b"}, func(s string) string { return s }); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	strs, length := []string{"a", "bb", "c"}, func(s string) int { return len(s) }
+	if s := fmt.Sprint(SetMapFuncAll(strs, length), BoolMapFuncAll(strs, length)); s != "map[1:[a c] 2:[bb]] map[1:[a c] 2:[bb]]" {
+		t.Errorf("unexpected maps %s", s)
+	}
+	if s := fmt.Sprint(SetMapFuncFirst(strs, length), BoolMapFuncFirst(strs, length)); s != "map[1:{} 2:{}] map[1:true 2:true]" {
+		t.Errorf("unexpected maps %s", s)
+	}
+}
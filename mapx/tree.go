@@ -0,0 +1,448 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"cmp"
+
+	"github.com/xgfone/go-generics/funcs"
+)
+
+type treeNode[K, V any] struct {
+	left, right *treeNode[K, V]
+	key         K
+	value       V
+	red         bool
+}
+
+// TreeMap is a sorted map based on the left-leaning red-black tree,
+// which supports the range and navigation queries.
+//
+// It is not thread-safe.
+type TreeMap[K, V any] struct {
+	compare func(a, b K) int
+	root    *treeNode[K, V]
+	size    int
+}
+
+// NewTreeMap returns a new TreeMap, the keys of which are sorted
+// by funcs.Compare.
+func NewTreeMap[K cmp.Ordered, V any]() *TreeMap[K, V] {
+	return NewTreeMapFunc[K, V](funcs.Compare[K])
+}
+
+// NewTreeMapFunc returns a new TreeMap, the keys of which are sorted
+// by the compare function.
+func NewTreeMapFunc[K, V any](compare func(a, b K) int) *TreeMap[K, V] {
+	if compare == nil {
+		panic("mapx.NewTreeMapFunc: the compare function must not be nil")
+	}
+	return &TreeMap[K, V]{compare: compare}
+}
+
+// Len returns the number of the key-value pairs.
+func (m *TreeMap[K, V]) Len() int {
+	return m.size
+}
+
+// Clear removes all the key-value pairs.
+func (m *TreeMap[K, V]) Clear() {
+	m.root, m.size = nil, 0
+}
+
+func (m *TreeMap[K, V]) find(k K) *treeNode[K, V] {
+	for n := m.root; n != nil; {
+		switch c := m.compare(k, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+// Get returns the value of the key.
+func (m *TreeMap[K, V]) Get(k K) (v V, ok bool) {
+	if n := m.find(k); n != nil {
+		v, ok = n.value, true
+	}
+	return
+}
+
+// Contains reports whether the key exists.
+func (m *TreeMap[K, V]) Contains(k K) bool {
+	return m.find(k) != nil
+}
+
+// Set sets the value of the key.
+func (m *TreeMap[K, V]) Set(k K, v V) {
+	m.root = m.put(m.root, k, v)
+	m.root.red = false
+}
+
+// Delete removes the key and returns the removed value.
+func (m *TreeMap[K, V]) Delete(k K) (v V, ok bool) {
+	n := m.find(k)
+	if n == nil {
+		return
+	}
+
+	v, ok = n.value, true
+	if !isRed(m.root.left) && !isRed(m.root.right) {
+		m.root.red = true
+	}
+	if m.root = m.delete(m.root, k); m.root != nil {
+		m.root.red = false
+	}
+	m.size--
+	return
+}
+
+func treeEntry[K, V any](n *treeNode[K, V]) (k K, v V, ok bool) {
+	if n != nil {
+		k, v, ok = n.key, n.value, true
+	}
+	return
+}
+
+// First returns the key-value pair with the least key.
+func (m *TreeMap[K, V]) First() (k K, v V, ok bool) {
+	return treeEntry(minNode(m.root))
+}
+
+// Last returns the key-value pair with the greatest key.
+func (m *TreeMap[K, V]) Last() (k K, v V, ok bool) {
+	return treeEntry(maxNode(m.root))
+}
+
+// PollFirst removes and returns the key-value pair with the least key.
+func (m *TreeMap[K, V]) PollFirst() (k K, v V, ok bool) {
+	if k, v, ok = m.First(); ok {
+		m.Delete(k)
+	}
+	return
+}
+
+// PollLast removes and returns the key-value pair with the greatest key.
+func (m *TreeMap[K, V]) PollLast() (k K, v V, ok bool) {
+	if k, v, ok = m.Last(); ok {
+		m.Delete(k)
+	}
+	return
+}
+
+// Floor returns the key-value pair with the greatest key less than or equal to k.
+func (m *TreeMap[K, V]) Floor(k K) (key K, value V, ok bool) {
+	var found *treeNode[K, V]
+	for n := m.root; n != nil; {
+		switch c := m.compare(k, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			found, n = n, n.right
+		default:
+			return treeEntry(n)
+		}
+	}
+	return treeEntry(found)
+}
+
+// Lower returns the key-value pair with the greatest key strictly less than k.
+func (m *TreeMap[K, V]) Lower(k K) (key K, value V, ok bool) {
+	var found *treeNode[K, V]
+	for n := m.root; n != nil; {
+		if m.compare(k, n.key) <= 0 {
+			n = n.left
+		} else {
+			found, n = n, n.right
+		}
+	}
+	return treeEntry(found)
+}
+
+// Ceiling returns the key-value pair with the least key greater than or equal to k.
+func (m *TreeMap[K, V]) Ceiling(k K) (key K, value V, ok bool) {
+	var found *treeNode[K, V]
+	for n := m.root; n != nil; {
+		switch c := m.compare(k, n.key); {
+		case c > 0:
+			n = n.right
+		case c < 0:
+			found, n = n, n.left
+		default:
+			return treeEntry(n)
+		}
+	}
+	return treeEntry(found)
+}
+
+// Higher returns the key-value pair with the least key strictly greater than k.
+func (m *TreeMap[K, V]) Higher(k K) (key K, value V, ok bool) {
+	var found *treeNode[K, V]
+	for n := m.root; n != nil; {
+		if m.compare(k, n.key) >= 0 {
+			n = n.right
+		} else {
+			found, n = n, n.left
+		}
+	}
+	return treeEntry(found)
+}
+
+// Keys returns all the keys in the ascending order.
+func (m *TreeMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.size)
+	m.Range(func(k K, _ V) bool { keys = append(keys, k); return true })
+	return keys
+}
+
+// Values returns all the values in the ascending order of the keys.
+func (m *TreeMap[K, V]) Values() []V {
+	values := make([]V, 0, m.size)
+	m.Range(func(_ K, v V) bool { values = append(values, v); return true })
+	return values
+}
+
+//////////////////////////////////////////////////////////////////////////////
+
+// Range travels all the key-value pairs in the ascending order
+// until f returns false.
+//
+// The map must not be modified during traveling.
+func (m *TreeMap[K, V]) Range(f func(k K, v V) bool) {
+	m.ascend(m.root, nil, nil, f)
+}
+
+// RangeReverse is the same as Range, but in the descending order.
+func (m *TreeMap[K, V]) RangeReverse(f func(k K, v V) bool) {
+	m.descend(m.root, nil, nil, f)
+}
+
+// RangeFrom travels the key-value pairs whose keys are greater than
+// or equal to from in the ascending order until f returns false.
+func (m *TreeMap[K, V]) RangeFrom(from K, f func(k K, v V) bool) {
+	m.ascend(m.root, &from, nil, f)
+}
+
+// RangeFromReverse is the same as RangeFrom, but in the descending order.
+func (m *TreeMap[K, V]) RangeFromReverse(from K, f func(k K, v V) bool) {
+	m.descend(m.root, &from, nil, f)
+}
+
+// RangeTo travels the key-value pairs whose keys are strictly less than to
+// in the ascending order until f returns false.
+func (m *TreeMap[K, V]) RangeTo(to K, f func(k K, v V) bool) {
+	m.ascend(m.root, nil, &to, f)
+}
+
+// RangeToReverse is the same as RangeTo, but in the descending order.
+func (m *TreeMap[K, V]) RangeToReverse(to K, f func(k K, v V) bool) {
+	m.descend(m.root, nil, &to, f)
+}
+
+// SubMap travels the key-value pairs whose keys are in the half-open
+// interval [from, to) in the ascending order until f returns false.
+func (m *TreeMap[K, V]) SubMap(from, to K, f func(k K, v V) bool) {
+	m.ascend(m.root, &from, &to, f)
+}
+
+// SubMapReverse is the same as SubMap, but in the descending order.
+func (m *TreeMap[K, V]) SubMapReverse(from, to K, f func(k K, v V) bool) {
+	m.descend(m.root, &from, &to, f)
+}
+
+// ascend travels the nodes in [from, to), and nil means no bound.
+func (m *TreeMap[K, V]) ascend(n *treeNode[K, V], from, to *K, f func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	geFrom := from == nil || m.compare(n.key, *from) >= 0
+	ltTo := to == nil || m.compare(n.key, *to) < 0
+
+	if geFrom && !m.ascend(n.left, from, to, f) {
+		return false
+	}
+	if geFrom && ltTo && !f(n.key, n.value) {
+		return false
+	}
+	if ltTo {
+		return m.ascend(n.right, from, to, f)
+	}
+	return true
+}
+
+// descend is the same as ascend, but in the descending order.
+func (m *TreeMap[K, V]) descend(n *treeNode[K, V], from, to *K, f func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	geFrom := from == nil || m.compare(n.key, *from) >= 0
+	ltTo := to == nil || m.compare(n.key, *to) < 0
+
+	if ltTo && !m.descend(n.right, from, to, f) {
+		return false
+	}
+	if geFrom && ltTo && !f(n.key, n.value) {
+		return false
+	}
+	if geFrom {
+		return m.descend(n.left, from, to, f)
+	}
+	return true
+}
+
+//////////////////////////////////////////////////////////////////////////////
+// Left-leaning red-black tree
+
+func isRed[K, V any](n *treeNode[K, V]) bool {
+	return n != nil && n.red
+}
+
+func minNode[K, V any](n *treeNode[K, V]) *treeNode[K, V] {
+	if n != nil {
+		for n.left != nil {
+			n = n.left
+		}
+	}
+	return n
+}
+
+func maxNode[K, V any](n *treeNode[K, V]) *treeNode[K, V] {
+	if n != nil {
+		for n.right != nil {
+			n = n.right
+		}
+	}
+	return n
+}
+
+func rotateLeft[K, V any](h *treeNode[K, V]) *treeNode[K, V] {
+	x := h.right
+	h.right = x.left
+	x.left = h
+	x.red = h.red
+	h.red = true
+	return x
+}
+
+func rotateRight[K, V any](h *treeNode[K, V]) *treeNode[K, V] {
+	x := h.left
+	h.left = x.right
+	x.right = h
+	x.red = h.red
+	h.red = true
+	return x
+}
+
+func flipColors[K, V any](h *treeNode[K, V]) {
+	h.red = !h.red
+	h.left.red = !h.left.red
+	h.right.red = !h.right.red
+}
+
+func balance[K, V any](h *treeNode[K, V]) *treeNode[K, V] {
+	if isRed(h.right) && !isRed(h.left) {
+		h = rotateLeft(h)
+	}
+	if isRed(h.left) && isRed(h.left.left) {
+		h = rotateRight(h)
+	}
+	if isRed(h.left) && isRed(h.right) {
+		flipColors(h)
+	}
+	return h
+}
+
+func moveRedLeft[K, V any](h *treeNode[K, V]) *treeNode[K, V] {
+	flipColors(h)
+	if isRed(h.right.left) {
+		h.right = rotateRight(h.right)
+		h = rotateLeft(h)
+		flipColors(h)
+	}
+	return h
+}
+
+func moveRedRight[K, V any](h *treeNode[K, V]) *treeNode[K, V] {
+	flipColors(h)
+	if isRed(h.left.left) {
+		h = rotateRight(h)
+		flipColors(h)
+	}
+	return h
+}
+
+func deleteMin[K, V any](h *treeNode[K, V]) *treeNode[K, V] {
+	if h.left == nil {
+		return nil
+	}
+	if !isRed(h.left) && !isRed(h.left.left) {
+		h = moveRedLeft(h)
+	}
+	h.left = deleteMin(h.left)
+	return balance(h)
+}
+
+func (m *TreeMap[K, V]) put(h *treeNode[K, V], k K, v V) *treeNode[K, V] {
+	if h == nil {
+		m.size++
+		return &treeNode[K, V]{key: k, value: v, red: true}
+	}
+
+	switch c := m.compare(k, h.key); {
+	case c < 0:
+		h.left = m.put(h.left, k, v)
+	case c > 0:
+		h.right = m.put(h.right, k, v)
+	default:
+		h.value = v
+	}
+
+	return balance(h)
+}
+
+// delete removes the key, which must exist in the tree rooted at h.
+func (m *TreeMap[K, V]) delete(h *treeNode[K, V], k K) *treeNode[K, V] {
+	if m.compare(k, h.key) < 0 {
+		if !isRed(h.left) && !isRed(h.left.left) {
+			h = moveRedLeft(h)
+		}
+		h.left = m.delete(h.left, k)
+		return balance(h)
+	}
+
+	if isRed(h.left) {
+		h = rotateRight(h)
+	}
+	if m.compare(k, h.key) == 0 && h.right == nil {
+		return nil
+	}
+	if !isRed(h.right) && !isRed(h.right.left) {
+		h = moveRedRight(h)
+	}
+	if m.compare(k, h.key) == 0 {
+		x := minNode(h.right)
+		h.key, h.value = x.key, x.value
+		h.right = deleteMin(h.right)
+	} else {
+		h.right = m.delete(h.right, k)
+	}
+	return balance(h)
+}
@@ -0,0 +1,186 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapx
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func ExampleTreeMap() {
+	m := NewTreeMap[int, string]()
+	for _, v := range []int{50, 10, 40, 20, 30} {
+		m.Set(v, fmt.Sprintf("v%d", v))
+	}
+
+	fmt.Println(m.Floor(35))
+	fmt.Println(m.Ceiling(35))
+	fmt.Println(m.Lower(10))
+	fmt.Println(m.Higher(50))
+
+	var keys []int
+	m.SubMap(20, 50, func(k int, v string) bool { keys = append(keys, k); return true })
+	fmt.Println(keys)
+
+	keys = keys[:0]
+	m.RangeFromReverse(30, func(k int, v string) bool { keys = append(keys, k); return true })
+	fmt.Println(keys)
+
+	fmt.Println(m.PollFirst())
+	fmt.Println(m.Keys())
+
+	// Output:
+	// 30 v30 true
+	// 40 v40 true
+	// 0  false
+	// 0  false
+	// [20 30 40]
+	// [50 40 30]
+	// 10 v10 true
+	// [20 30 40 50]
+}
+
+// checkTree checks the invariants of the left-leaning red-black tree
+// and returns the black height.
+func checkTree[K, V any](t *testing.T, m *TreeMap[K, V], n *treeNode[K, V]) int {
+	if n == nil {
+		return 1
+	}
+
+	if isRed(n.right) {
+		t.Fatalf("the right link of %v is red", n.key)
+	}
+	if isRed(n) && isRed(n.left) {
+		t.Fatalf("two red links in a row at %v", n.key)
+	}
+	if n.left != nil && m.compare(n.left.key, n.key) >= 0 {
+		t.Fatalf("the left key %v is not less than %v", n.left.key, n.key)
+	}
+	if n.right != nil && m.compare(n.right.key, n.key) <= 0 {
+		t.Fatalf("the right key %v is not greater than %v", n.right.key, n.key)
+	}
+
+	lh, rh := checkTree(t, m, n.left), checkTree(t, m, n.right)
+	if lh != rh {
+		t.Fatalf("the tree is not black-balanced at %v", n.key)
+	}
+	if !n.red {
+		lh++
+	}
+	return lh
+}
+
+func TestTreeMap(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	m := NewTreeMap[int, int]()
+	expect := make(map[int]int)
+
+	for i := 0; i < 2000; i++ {
+		k := r.Intn(500)
+		if r.Intn(3) == 0 {
+			_, ok1 := m.Delete(k)
+			_, ok2 := expect[k]
+			if ok1 != ok2 {
+				t.Fatalf("delete %d: expect %v, but got %v", k, ok2, ok1)
+			}
+			delete(expect, k)
+		} else {
+			m.Set(k, i)
+			expect[k] = i
+		}
+
+		if m.Len() != len(expect) {
+			t.Fatalf("expect length %d, but got %d", len(expect), m.Len())
+		}
+		checkTree(t, m, m.root)
+	}
+
+	keys := Keys(expect)
+	slices.Sort(keys)
+	if got := m.Keys(); !slices.Equal(got, keys) {
+		t.Fatalf("expect keys %v, but got %v", keys, got)
+	}
+
+	for k := -1; k <= 501; k++ {
+		i, found := slices.BinarySearch(keys, k)
+
+		check := func(name string, idx int, exist bool) {
+			key, _, ok := map[string]func(int) (int, int, bool){
+				"Floor": m.Floor, "Lower": m.Lower, "Ceiling": m.Ceiling, "Higher": m.Higher,
+			}[name](k)
+			if exist = exist && idx >= 0 && idx < len(keys); ok != exist || (ok && key != keys[idx]) {
+				t.Fatalf("%s(%d): unexpected %v %v", name, k, key, ok)
+			}
+		}
+
+		if found {
+			check("Floor", i, true)
+			check("Ceiling", i, true)
+			check("Higher", i+1, true)
+		} else {
+			check("Floor", i-1, true)
+			check("Ceiling", i, true)
+			check("Higher", i, true)
+		}
+		check("Lower", i-1, true)
+	}
+
+	var got []int
+	m.SubMapReverse(100, 200, func(k, _ int) bool { got = append(got, k); return true })
+	var want []int
+	for i := len(keys) - 1; i >= 0; i-- {
+		if keys[i] >= 100 && keys[i] < 200 {
+			want = append(want, keys[i])
+		}
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("expect %v, but got %v", want, got)
+	}
+
+	got = got[:0]
+	m.RangeTo(100, func(k, _ int) bool { got = append(got, k); return len(got) < 3 })
+	if !slices.Equal(got, keys[:3]) {
+		t.Errorf("expect %v, but got %v", keys[:3], got)
+	}
+
+	for m.Len() > 0 {
+		if _, _, ok := m.PollLast(); !ok {
+			t.Fatal("fail to poll the last")
+		}
+		checkTree(t, m, m.root)
+	}
+}
+
+func TestTreeMapFunc(t *testing.T) {
+	m := NewTreeMapFunc[string, int](func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	m.Set("b", 1)
+	m.Set("A", 2)
+	m.Set("B", 3)
+
+	if keys := m.Keys(); !slices.Equal(keys, []string{"A", "b"}) {
+		t.Errorf("expect %v, but got %v", []string{"A", "b"}, keys)
+	}
+	if v, _ := m.Get("a"); v != 2 {
+		t.Errorf("expect %d, but got %d", 2, v)
+	}
+	if v, _ := m.Get("B"); v != 3 {
+		t.Errorf("expect %d, but got %d", 3, v)
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package set
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/xgfone/go-generics/internal/order"
+)
+
+// FormatLimit is the default maximum number of the elements formatted by fmt,
+// such as fmt.Sprint(s) and fmt.Printf("%v", s), and the rest elements are
+// elided as "... (+N more)". Use Formatter.Limit to change it.
+//
+// Notice: String does not elide any element, so fmt.Sprint(s) and s.String()
+// are different for the set with more than FormatLimit elements.
+const FormatLimit = 100
+
+var (
+	_ fmt.Formatter = Set[int]{}
+	_ fmt.Formatter = Formatter[int]{}
+)
+
+// Formatter is used to format a set with a custom comparator or limit.
+type Formatter[T comparable] struct {
+	set      Set[T]
+	compare  func(a, b T) int
+	limit    int
+	hasLimit bool
+}
+
+// FormatFunc returns a formatter of the set, which sorts the elements
+// by the compare function when formatting. If compare is nil,
+// use the default order like Format.
+//
+// Example:
+//
+//	fmt.Printf("%v", s.FormatFunc(func(a, b User) int { return cmp.Compare(a.ID, b.ID) }))
+func (s Set[T]) FormatFunc(compare func(a, b T) int) Formatter[T] {
+	return Formatter[T]{set: s, compare: compare}
+}
+
+// Limit returns a new formatter with the maximum number of the elements
+// to be formatted, which overrides FormatLimit.
+//
+// If n is not positive, no element is elided.
+func (f Formatter[T]) Limit(n int) Formatter[T] {
+	f.limit, f.hasLimit = n, true
+	return f
+}
+
+// Format implements the interface fmt.Formatter.
+func (f Formatter[T]) Format(state fmt.State, verb rune) {
+	limit := FormatLimit
+	if f.hasLimit {
+		limit = f.limit
+	}
+	format(state, verb, f.set.sorted(f.compare), limit)
+}
+
+// Format implements the interface fmt.Formatter.
+//
+// The set is formatted as "{e1 e2 ...}", the elements of which are sorted
+// if T is an ordered type, such as integer, float or string. Or, they are
+// sorted by their formatted strings. The verb, the flags except "+",
+// the width and the precision are applied to each element, such as "%.2f"
+// and "%5d". The elements beyond FormatLimit are elided. The flag "+"
+// appends the size, like "{1 2 3} (size=3)".
+//
+// The verb %#v formats the set as the Go-syntax, like "set.New[int](1, 2, 3)".
+func (s Set[T]) Format(state fmt.State, verb rune) {
+	format(state, verb, s.sorted(nil), FormatLimit)
+}
+
+// String returns the string of all the sorted elements, like "{1 2 3}",
+// which does not elide any element unlike Format. See FormatLimit.
+func (s Set[T]) String() string {
+	var b strings.Builder
+	writeElements(&b, s.sorted(nil), -1, "")
+	return b.String()
+}
+
+func format[T comparable](state fmt.State, verb rune, elems []T, limit int) {
+	if verb == 'v' && state.Flag('#') {
+		fmt.Fprintf(state, "set.New[%s](", reflect.TypeOf((*T)(nil)).Elem())
+		for i, e := range elems {
+			if i > 0 {
+				_, _ = state.Write([]byte(", "))
+			}
+			fmt.Fprintf(state, "%#v", e)
+		}
+		_, _ = state.Write([]byte{')'})
+		return
+	}
+
+	if limit <= 0 {
+		limit = -1
+	}
+
+	var b strings.Builder
+	writeElements(&b, elems, limit, elementFormat(state, verb))
+	if state.Flag('+') {
+		b.WriteString(" (size=")
+		b.WriteString(strconv.Itoa(len(elems)))
+		b.WriteByte(')')
+	}
+	_, _ = state.Write([]byte(b.String()))
+}
+
+// elementFormat rebuilds the format string of each element from the state
+// without the flag "+", which returns "" for the plain "%v" and "%s".
+func elementFormat(state fmt.State, verb rune) string {
+	var b strings.Builder
+	b.WriteByte('%')
+	for _, flag := range "-# 0" {
+		if state.Flag(int(flag)) {
+			b.WriteRune(flag)
+		}
+	}
+	if width, ok := state.Width(); ok {
+		b.WriteString(strconv.Itoa(width))
+	}
+	if prec, ok := state.Precision(); ok {
+		b.WriteByte('.')
+		b.WriteString(strconv.Itoa(prec))
+	}
+
+	if b.Len() == 1 && (verb == 'v' || verb == 's') {
+		return ""
+	}
+
+	b.WriteRune(verb)
+	return b.String()
+}
+
+// writeElements writes the elements formatted by the format string,
+// which uses the fast path of writeElement if format is "".
+func writeElements[T comparable](b *strings.Builder, elems []T, limit int, format string) {
+	n := len(elems)
+	if limit >= 0 && n > limit {
+		n = limit
+	}
+
+	b.Grow(n*4 + 2)
+	b.WriteByte('{')
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+
+		if format == "" {
+			writeElement(b, elems[i])
+		} else {
+			fmt.Fprintf(b, format, elems[i])
+		}
+	}
+
+	if rest := len(elems) - n; rest > 0 {
+		if n > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString("... (+")
+		b.WriteString(strconv.Itoa(rest))
+		b.WriteString(" more)")
+	}
+	b.WriteByte('}')
+}
+
+func writeElement(b *strings.Builder, e any) {
+	// Fast path for the builtin types, which does not use fmt.
+	switch v := e.(type) {
+	case string:
+		b.WriteString(v)
+	case int:
+		b.WriteString(strconv.FormatInt(int64(v), 10))
+	case int8:
+		b.WriteString(strconv.FormatInt(int64(v), 10))
+	case int16:
+		b.WriteString(strconv.FormatInt(int64(v), 10))
+	case int32:
+		b.WriteString(strconv.FormatInt(int64(v), 10))
+	case int64:
+		b.WriteString(strconv.FormatInt(v, 10))
+	case uint:
+		b.WriteString(strconv.FormatUint(uint64(v), 10))
+	case uint8:
+		b.WriteString(strconv.FormatUint(uint64(v), 10))
+	case uint16:
+		b.WriteString(strconv.FormatUint(uint64(v), 10))
+	case uint32:
+		b.WriteString(strconv.FormatUint(uint64(v), 10))
+	case uint64:
+		b.WriteString(strconv.FormatUint(v, 10))
+	case bool:
+		b.WriteString(strconv.FormatBool(v))
+	default:
+		fmt.Fprintf(b, "%v", e)
+	}
+}
+
+// sorted returns the sorted elements of the set.
+//
+// If compare is nil, sort them by the natural order if T is an ordered type,
+// or by their formatted strings.
+func (s Set[T]) sorted(compare func(a, b T) int) []T {
+	elems := s.Slice()
+	if len(elems) < 2 {
+		return elems
+	}
+
+	if compare != nil {
+		slices.SortFunc(elems, compare)
+		return elems
+	}
+
+	order.Sort(elems)
+	return elems
+}
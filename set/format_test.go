@@ -0,0 +1,86 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package set
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func ExampleSet_Format() {
+	s := NewSet(3, 1, 2, 5, 4)
+	fmt.Printf("%v\n", s)
+	fmt.Printf("%+v\n", s)
+	fmt.Printf("%v\n", s.FormatFunc(nil).Limit(2))
+	fmt.Printf("%#v\n", s)
+	fmt.Printf("%x\n", NewSet(10, 11))
+	fmt.Printf("%.2f|%3d\n", NewSet(1.234, 2.345), NewSet(1, 2))
+	fmt.Println(NewSet("c", "a", "b"))
+
+	type point struct{ X, Y int }
+	points := NewSet(point{2, 1}, point{1, 2})
+	fmt.Printf("%v\n", points)
+	fmt.Printf("%v\n", points.FormatFunc(func(a, b point) int { return cmp.Compare(b.X, a.X) }))
+
+	// Output:
+	// {1 2 3 4 5}
+	// {1 2 3 4 5} (size=5)
+	// {1 2 ... (+3 more)}
+	// set.New[int](1, 2, 3, 4, 5)
+	// {a b}
+	// {1.23 2.35}|{  1   2}
+	// {a b c}
+	// {{1 2} {2 1}}
+	// {{2 1} {1 2}}
+}
+
+func TestSetFormatLimit(t *testing.T) {
+	s := NewSetWithCap[int](200)
+	for i := 0; i < 200; i++ {
+		s.Add(i)
+	}
+
+	expect := "{0 1 2 ... (+197 more)} (size=200)"
+	if v := fmt.Sprintf("%+v", s.FormatFunc(nil).Limit(3)); v != expect {
+		t.Errorf("expect '%s', but got '%s'", expect, v)
+	}
+
+	if v := fmt.Sprintf("%v", s); !strings.HasSuffix(v, " 99 ... (+100 more)}") {
+		t.Errorf("expect to elide by FormatLimit, but got '%s'", v)
+	}
+
+	if v := fmt.Sprintf("%v", s.FormatFunc(nil).Limit(0)); strings.Contains(v, "more") {
+		t.Errorf("expect not to elide any element, but got '%s'", v)
+	}
+
+	if v := fmt.Sprintf("%#v", NewSet[any](1)); v != "set.New[interface {}](1)" {
+		t.Errorf("unexpected Go-syntax format '%s'", v)
+	}
+
+	if v := fmt.Sprint(NewSet[int]()); v != "{}" {
+		t.Errorf("expect '%s', but got '%s'", "{}", v)
+	}
+
+	type ID int
+	if v := fmt.Sprint(NewSet[ID](10, 2, 1)); v != "{1 2 10}" {
+		t.Errorf("expect '%s', but got '%s'", "{1 2 10}", v)
+	}
+
+	if v := s.String(); len(v) < 200 {
+		t.Errorf("expect String not to elide any element, but got '%s'", v)
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package set
+
+// Policy is used to decide which value is kept when the values
+// of two keyed sets have the same key.
+type Policy int
+
+const (
+	// KeepLeft keeps the value in the left set, that's, the receiver.
+	KeepLeft Policy = iota
+
+	// KeepRight keeps the value in the right set, that's, the argument.
+	KeepRight
+)
+
+// KeyedSet is a set of values, which are unique by the key
+// extracted from the value.
+type KeyedSet[K comparable, V any] struct {
+	getkey func(V) K
+	cache  map[K]V
+}
+
+// NewKeyedSet returns a new KeyedSet with the key function getkey.
+//
+// If the values have the same key, the last one wins.
+func NewKeyedSet[K comparable, V any](getkey func(V) K, values ...V) KeyedSet[K, V] {
+	if getkey == nil {
+		panic("set.NewKeyedSet: the key function must not be nil")
+	}
+
+	s := KeyedSet[K, V]{getkey: getkey, cache: make(map[K]V, len(values))}
+	s.Add(values...)
+	return s
+}
+
+func (s KeyedSet[K, V]) new(cap int) KeyedSet[K, V] {
+	return KeyedSet[K, V]{getkey: s.getkey, cache: make(map[K]V, cap)}
+}
+
+// Add adds the values into the set, which replaces the old value
+// with the same key.
+func (s KeyedSet[K, V]) Add(values ...V) {
+	for _, v := range values {
+		s.cache[s.getkey(v)] = v
+	}
+}
+
+// TryAdd adds the value into the set only if its key does not exist,
+// and reports whether it is added.
+func (s KeyedSet[K, V]) TryAdd(value V) (ok bool) {
+	k := s.getkey(value)
+	if _, exist := s.cache[k]; !exist {
+		s.cache[k] = value
+		ok = true
+	}
+	return
+}
+
+// Remove removes the values by the keys from the set.
+func (s KeyedSet[K, V]) Remove(keys ...K) {
+	for _, k := range keys {
+		delete(s.cache, k)
+	}
+}
+
+// Pop removes and returns an arbitrary value from the set.
+func (s KeyedSet[K, V]) Pop() (value V, ok bool) {
+	for k, v := range s.cache {
+		delete(s.cache, k)
+		return v, true
+	}
+	return
+}
+
+// Clear removes all the values from the set.
+func (s KeyedSet[K, V]) Clear() {
+	for k := range s.cache {
+		delete(s.cache, k)
+	}
+}
+
+// Get returns the value by the key.
+func (s KeyedSet[K, V]) Get(key K) (value V, ok bool) {
+	value, ok = s.cache[key]
+	return
+}
+
+// Contains returns true if the key is in the set. Or return false.
+func (s KeyedSet[K, V]) Contains(key K) bool {
+	_, ok := s.cache[key]
+	return ok
+}
+
+// ContainsValue returns true if the key of the value is in the set.
+func (s KeyedSet[K, V]) ContainsValue(value V) bool {
+	_, ok := s.cache[s.getkey(value)]
+	return ok
+}
+
+// Equal returns true if the keys of s are equal to those of other.
+func (s KeyedSet[K, V]) Equal(other KeyedSet[K, V]) bool {
+	if len(s.cache) != len(other.cache) {
+		return false
+	}
+
+	for k := range s.cache {
+		if _, ok := other.cache[k]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Size returns the number of the values in the set.
+func (s KeyedSet[K, V]) Size() int {
+	return len(s.cache)
+}
+
+// Keys returns the keys of all the values.
+func (s KeyedSet[K, V]) Keys() []K {
+	keys := make([]K, 0, len(s.cache))
+	for k := range s.cache {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Slice converts the set to a slice of the values.
+func (s KeyedSet[K, V]) Slice() []V {
+	values := make([]V, 0, len(s.cache))
+	for _, v := range s.cache {
+		values = append(values, v)
+	}
+	return values
+}
+
+// KeySet converts the set to a Set of the keys.
+func (s KeyedSet[K, V]) KeySet() Set[K] {
+	keys := NewSetWithCap[K](len(s.cache))
+	for k := range s.cache {
+		keys.cache[k] = struct{}{}
+	}
+	return keys
+}
+
+// Clone returns a copy of the current set.
+func (s KeyedSet[K, V]) Clone() KeyedSet[K, V] {
+	cs := s.new(len(s.cache))
+	for k, v := range s.cache {
+		cs.cache[k] = v
+	}
+	return cs
+}
+
+// Range travels all the key-value pairs of the set.
+func (s KeyedSet[K, V]) Range(f func(key K, value V)) {
+	for k, v := range s.cache {
+		f(k, v)
+	}
+}
+
+//////////////////////////////////////////////////////////////////////////////
+
+// UnionUpdate updates the set, adding the values from all others.
+//
+// For the same key, keep the value according to the policy.
+func (s KeyedSet[K, V]) UnionUpdate(policy Policy, others ...KeyedSet[K, V]) {
+	for _, set := range others {
+		for k, v := range set.cache {
+			if _, ok := s.cache[k]; !ok || policy == KeepRight {
+				s.cache[k] = v
+			}
+		}
+	}
+}
+
+// DifferenceUpdate updates the set, removing the values whose keys are found in others.
+func (s KeyedSet[K, V]) DifferenceUpdate(others ...KeyedSet[K, V]) {
+	for _, set := range others {
+		for k := range set.cache {
+			delete(s.cache, k)
+		}
+	}
+}
+
+// IntersectionUpdate updates the set, keeping only the values
+// whose keys are found in it and all others.
+//
+// For the same key, keep the value according to the policy.
+// For KeepRight, the value in the last other set is kept.
+func (s KeyedSet[K, V]) IntersectionUpdate(policy Policy, others ...KeyedSet[K, V]) {
+	for k := range s.cache {
+		v, ok := s.cache[k], true
+		for _, set := range others {
+			if v, ok = set.cache[k]; !ok {
+				break
+			}
+		}
+
+		switch {
+		case !ok:
+			delete(s.cache, k)
+		case policy == KeepRight:
+			s.cache[k] = v
+		}
+	}
+}
+
+// SymmetricDifferenceUpdate updates the set, keeping only the values
+// whose keys are found in either set, but not in both.
+func (s KeyedSet[K, V]) SymmetricDifferenceUpdate(other KeyedSet[K, V]) {
+	for k, v := range other.cache {
+		if _, ok := s.cache[k]; ok {
+			delete(s.cache, k)
+		} else {
+			s.cache[k] = v
+		}
+	}
+}
+
+//////////////////////////////////////////////////////////////////////////////
+
+// Union returns a new set with the values from the set and all others.
+//
+// For the same key, keep the value according to the policy.
+func (s KeyedSet[K, V]) Union(policy Policy, others ...KeyedSet[K, V]) KeyedSet[K, V] {
+	r := s.Clone()
+	r.UnionUpdate(policy, others...)
+	return r
+}
+
+// Difference returns a new set with the values in the set
+// whose keys are not in the others.
+func (s KeyedSet[K, V]) Difference(others ...KeyedSet[K, V]) KeyedSet[K, V] {
+	r := s.Clone()
+	r.DifferenceUpdate(others...)
+	return r
+}
+
+// Intersection returns a new set with the values whose keys are common
+// to the set and all others.
+//
+// For the same key, keep the value according to the policy.
+// For KeepRight, the value in the last other set is kept.
+func (s KeyedSet[K, V]) Intersection(policy Policy, others ...KeyedSet[K, V]) KeyedSet[K, V] {
+	r := s.Clone()
+	r.IntersectionUpdate(policy, others...)
+	return r
+}
+
+// SymmetricDifference returns a new set with the values whose keys are
+// in either the set or other but not both.
+func (s KeyedSet[K, V]) SymmetricDifference(other KeyedSet[K, V]) KeyedSet[K, V] {
+	r := s.Clone()
+	r.SymmetricDifferenceUpdate(other)
+	return r
+}
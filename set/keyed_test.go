@@ -0,0 +1,100 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package set
+
+import (
+	"fmt"
+	"testing"
+)
+
+type user struct {
+	ID   int64
+	Name string
+}
+
+func userID(u user) int64 { return u.ID }
+
+func ExampleKeyedSet() {
+	users := NewKeyedSet(userID, user{ID: 1, Name: "a"}, user{ID: 2, Name: "b"})
+	others := NewKeyedSet(userID, user{ID: 2, Name: "B"}, user{ID: 3, Name: "c"})
+
+	left := users.Union(KeepLeft, others)
+	right := users.Union(KeepRight, others)
+	fmt.Println(left.Size(), right.Size())
+
+	u, _ := left.Get(2)
+	fmt.Println(u.Name)
+	u, _ = right.Get(2)
+	fmt.Println(u.Name)
+
+	fmt.Println(users.Intersection(KeepRight, others).KeySet())
+	fmt.Println(users.Difference(others).KeySet())
+
+	// Output:
+	// 3 3
+	// b
+	// B
+	// {2}
+	// {1}
+}
+
+func TestKeyedSet(t *testing.T) {
+	s1 := NewKeyedSet(userID, user{ID: 1, Name: "a"}, user{ID: 2, Name: "b"}, user{ID: 3, Name: "c"})
+	s2 := NewKeyedSet(userID, user{ID: 2, Name: "B"}, user{ID: 3, Name: "C"}, user{ID: 4, Name: "D"})
+
+	if s1.TryAdd(user{ID: 1, Name: "x"}) {
+		t.Errorf("unexpect to add the value with the existed key")
+	}
+	if u, _ := s1.Get(1); u.Name != "a" {
+		t.Errorf("expect '%s', but got '%s'", "a", u.Name)
+	}
+
+	inter := s1.Intersection(KeepLeft, s2)
+	if expect := NewSet[int64](2, 3); !inter.KeySet().Equal(expect) {
+		t.Errorf("expect %v, but got %v", expect, inter.KeySet())
+	}
+	if u, _ := inter.Get(2); u.Name != "b" {
+		t.Errorf("expect '%s', but got '%s'", "b", u.Name)
+	}
+	if u, _ := s1.Intersection(KeepRight, s2).Get(3); u.Name != "C" {
+		t.Errorf("expect '%s', but got '%s'", "C", u.Name)
+	}
+
+	sdiff := s1.SymmetricDifference(s2)
+	if expect := NewSet[int64](1, 4); !sdiff.KeySet().Equal(expect) {
+		t.Errorf("expect %v, but got %v", expect, sdiff.KeySet())
+	}
+
+	clone := s1.Clone()
+	clone.DifferenceUpdate(s2)
+	if clone.Size() != 1 || s1.Size() != 3 {
+		t.Errorf("expect the clone to be independent")
+	}
+	if !clone.ContainsValue(user{ID: 1}) || clone.Contains(2) {
+		t.Errorf("unexpected difference %v", clone.KeySet())
+	}
+
+	if !s1.Equal(NewKeyedSet(userID, user{ID: 3}, user{ID: 2}, user{ID: 1})) {
+		t.Errorf("expect the keyed sets to be equal")
+	}
+
+	s1.Remove(1, 2)
+	if v, ok := s1.Pop(); !ok || v.ID != 3 {
+		t.Errorf("expect to pop %v, but got %v", 3, v.ID)
+	}
+	if s1.Size() != 0 {
+		t.Errorf("expect an empty set, but got %d", s1.Size())
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package set
+
+import "math/rand"
+
+// Rand is a random number generator, which is implemented by *rand.Rand
+// in the package math/rand/v2.
+type Rand interface {
+	// IntN returns a non-negative pseudo-random number in the half-open
+	// interval [0,n). It panics if n <= 0.
+	IntN(n int) int
+}
+
+func intn(r Rand, n int) int {
+	if r == nil {
+		return rand.Intn(n)
+	}
+	return r.IntN(n)
+}
+
+// elements returns the elements of the set for the random sampling.
+//
+// If r is not nil, they are sorted so that the result is reproducible
+// with the same random source.
+func (s Set[T]) elements(r Rand) []T {
+	if r == nil {
+		return s.Slice()
+	}
+	return s.sorted(nil)
+}
+
+// RandomElement returns an uniformly random element from the set.
+//
+// If r is nil, use the global random source, which costs O(n). Or, the elements
+// are sorted firstly to make the result reproducible with the same random source,
+// which costs O(n log n) for each call. For the repeated sampling or popping,
+// use Sampler instead, which only sorts the elements once.
+func (s Set[T]) RandomElement(r Rand) (element T, ok bool) {
+	_len := len(s.cache)
+	if _len == 0 {
+		return
+	}
+
+	if r != nil {
+		return s.sorted(nil)[r.IntN(_len)], true
+	}
+
+	i := intn(nil, _len)
+	for e := range s.cache {
+		if i == 0 {
+			return e, true
+		}
+		i--
+	}
+	panic("unreachable")
+}
+
+// PopRandom removes and returns an uniformly random element from the set.
+//
+// For r and the cost, see RandomElement. To pop the elements repeatedly,
+// use Sampler.PopRandom instead.
+func (s Set[T]) PopRandom(r Rand) (element T, ok bool) {
+	if element, ok = s.RandomElement(r); ok {
+		delete(s.cache, element)
+	}
+	return
+}
+
+// Sample returns k distinct elements chosen uniformly at random from the set.
+// If k is not less than the size of the set, return all the elements
+// in a random order.
+//
+// For r, see RandomElement.
+func (s Set[T]) Sample(k int, r Rand) []T {
+	return sample(s.elements(r), k, r)
+}
+
+// sample moves k random elements to the front of elems by the partial
+// Fisher-Yates shuffle and returns a copy of them.
+func sample[T any](elems []T, k int, r Rand) []T {
+	_len := len(elems)
+	if k > _len {
+		k = _len
+	}
+	if k <= 0 {
+		return []T{}
+	}
+
+	for i := 0; i < k; i++ {
+		j := i + intn(r, _len-i)
+		elems[i], elems[j] = elems[j], elems[i]
+	}
+
+	return append(make([]T, 0, k), elems[:k]...)
+}
+
+// Sampler is used to sample the elements from a snapshot of a set repeatedly,
+// which does not reflect the changes of the set after it is created.
+type Sampler[T comparable] struct {
+	set   Set[T]
+	elems []T
+	rand  Rand
+}
+
+// Sampler returns a new sampler based on the snapshot of the current set.
+//
+// For r, see RandomElement. But the elements are sorted only once here.
+func (s Set[T]) Sampler(r Rand) *Sampler[T] {
+	return &Sampler[T]{set: s, elems: s.elements(r), rand: r}
+}
+
+// Size returns the number of the elements in the snapshot.
+func (s *Sampler[T]) Size() int {
+	return len(s.elems)
+}
+
+// RandomElement returns an uniformly random element in O(1).
+func (s *Sampler[T]) RandomElement() (element T, ok bool) {
+	if _len := len(s.elems); _len > 0 {
+		element, ok = s.elems[intn(s.rand, _len)], true
+	}
+	return
+}
+
+// Sample returns k distinct elements chosen uniformly at random in O(k).
+func (s *Sampler[T]) Sample(k int) []T {
+	return sample(s.elems, k, s.rand)
+}
+
+// PopRandom removes an uniformly random element from the snapshot
+// and the original set, and returns it in O(1).
+//
+// So popping all the elements costs O(n log n) with a non-nil random source,
+// rather than O(n^2 log n) by calling Set.PopRandom repeatedly.
+func (s *Sampler[T]) PopRandom() (element T, ok bool) {
+	_len := len(s.elems)
+	if _len == 0 {
+		return
+	}
+
+	i := intn(s.rand, _len)
+	element, ok = s.elems[i], true
+	s.elems[i] = s.elems[_len-1]
+	s.elems = s.elems[:_len-1]
+	delete(s.set.cache, element)
+	return
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package set
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// testRand adapts *rand.Rand of math/rand to Rand.
+type testRand struct{ *rand.Rand }
+
+func (r testRand) IntN(n int) int { return r.Intn(n) }
+
+func newTestRand(seed int64) Rand { return testRand{rand.New(rand.NewSource(seed))} }
+
+func TestSetSample(t *testing.T) {
+	s := NewSet(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+
+	s1 := s.Sample(5, newTestRand(1))
+	s2 := s.Sample(5, newTestRand(1))
+	if !slices.Equal(s1, s2) {
+		t.Errorf("expect the reproducible samples, but got %v and %v", s1, s2)
+	}
+	if NewSet(s1...).Size() != 5 {
+		t.Errorf("expect 5 distinct elements, but got %v", s1)
+	}
+
+	if all := s.Sample(20, nil); !NewSet(all...).Equal(s) {
+		t.Errorf("expect all the elements, but got %v", all)
+	}
+	if v := s.Sample(0, nil); len(v) != 0 {
+		t.Errorf("expect no elements, but got %v", v)
+	}
+
+	e1, _ := s.RandomElement(newTestRand(2))
+	e2, _ := s.RandomElement(newTestRand(2))
+	if e1 != e2 {
+		t.Errorf("expect the reproducible elements, but got %v and %v", e1, e2)
+	}
+
+	if _, ok := NewSet[int]().RandomElement(nil); ok {
+		t.Errorf("unexpect an element from the empty set")
+	}
+
+	for s.Size() > 0 {
+		size := s.Size()
+		e, ok := s.PopRandom(nil)
+		if !ok || s.Contains(e) || s.Size() != size-1 {
+			t.Fatalf("fail to pop the random element %v", e)
+		}
+	}
+}
+
+func TestSetRandomElementUniform(t *testing.T) {
+	const n, rounds = 4, 40000
+
+	s := NewSet(0, 1, 2, 3)
+	sampler := s.Sampler(newTestRand(3))
+
+	var counts1, counts2 [n]int
+	for i := 0; i < rounds; i++ {
+		e, _ := s.RandomElement(nil)
+		counts1[e]++
+
+		e, _ = sampler.RandomElement()
+		counts2[e]++
+	}
+
+	for i := 0; i < n; i++ {
+		for _, count := range []int{counts1[i], counts2[i]} {
+			if count < rounds/n*9/10 || count > rounds/n*11/10 {
+				t.Errorf("the element %d is not uniform: %v, %v", i, counts1, counts2)
+			}
+		}
+	}
+
+	if v := sampler.Sample(2); len(v) != 2 || v[0] == v[1] {
+		t.Errorf("expect 2 distinct elements, but got %v", v)
+	}
+}
+
+func TestSamplerPopRandom(t *testing.T) {
+	pop := func() []int {
+		s := NewSet(0, 1, 2, 3, 4, 5, 6, 7)
+		sampler := s.Sampler(newTestRand(5))
+
+		var popped []int
+		for {
+			e, ok := sampler.PopRandom()
+			if !ok {
+				break
+			}
+			if s.Contains(e) || sampler.Size() != s.Size() {
+				t.Fatalf("fail to pop the element %d from the set", e)
+			}
+			popped = append(popped, e)
+		}
+
+		if len(popped) != 8 || s.Size() != 0 {
+			t.Fatalf("expect to pop all the elements, but got %v", popped)
+		}
+		return popped
+	}
+
+	if p1, p2 := pop(), pop(); !slices.Equal(p1, p2) {
+		t.Errorf("expect the reproducible result, but got %v and %v", p1, p2)
+	}
+}
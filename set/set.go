@@ -15,11 +15,6 @@
 // Package set provides a common set based on generics.
 package set
 
-import (
-	"bytes"
-	"fmt"
-)
-
 // Set is a set type.
 type Set[T comparable] struct {
 	cache map[T]struct{}
@@ -49,23 +44,6 @@ func NewSetFromSet[T comparable](sets ...Set[T]) Set[T] {
 	return s
 }
 
-func (s Set[T]) String() string {
-	var i int
-	buf := bytes.NewBuffer(nil)
-	buf.Grow(128)
-	buf.WriteByte('{')
-	for key := range s.cache {
-		if i == 0 {
-			fmt.Fprintf(buf, "%v", key)
-		} else {
-			fmt.Fprintf(buf, " %v", key)
-		}
-		i++
-	}
-	buf.WriteByte('}')
-	return buf.String()
-}
-
 // Add adds some elements into the set.
 func (s Set[T]) Add(elements ...T) {
 	for _, v := range elements {
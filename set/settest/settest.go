@@ -0,0 +1,363 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package settest provides the property-based checks of the set algebra laws
+// for the set implementations, such as set.Set and its wrappers.
+//
+// Example:
+//
+//	func TestMySet(t *testing.T) {
+//		settest.New(NewMySet[int], settest.Ints(64)).Run(t)
+//	}
+//
+//	func FuzzMySet(f *testing.F) {
+//		settest.New(NewMySet[int], settest.Ints(64)).Fuzz(f)
+//	}
+//
+// The intersection with no others is not fixed by the laws. set.Set keeps
+// all the elements like Python, and returning the empty set is also accepted.
+// But Intersection() and IntersectionUpdate() must agree with each other,
+// so IntersectionUpdate() keeping everything while Intersection() returns
+// the empty set, or the reverse, is reported.
+package settest
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// Interface is the interface of the set implementation to be checked,
+// the methods of which have the same signatures as set.Set.
+type Interface[S any, T comparable] interface {
+	Add(elements ...T)
+	Contains(element T) bool
+	Size() int
+	Slice() []T
+	Equal(other S) bool
+	Clone() S
+
+	Union(others ...S) S
+	Intersection(others ...S) S
+	Difference(others ...S) S
+	SymmetricDifference(other S) S
+}
+
+// Updater is the interface of the pointer to the set implementation,
+// which updates the set in place.
+type Updater[S any, T comparable] interface {
+	*S
+
+	UnionUpdate(others ...S)
+	IntersectionUpdate(others ...S)
+	DifferenceUpdate(others ...S)
+	SymmetricDifferenceUpdate(other S)
+}
+
+// Ints returns a generator of the integers in [0, n).
+//
+// A small n makes the generated sets overlap more.
+func Ints(n int) func(*rand.Rand) int {
+	return func(r *rand.Rand) int { return r.Intn(n) }
+}
+
+// Tester is used to check the set algebra laws for a set implementation.
+type Tester[S Interface[S, T], PS Updater[S, T], T comparable] struct {
+	// Seed is the seed of the random generator used by Run.
+	//
+	// Default: 1
+	Seed int64
+
+	// Iterations is the number of the groups of the random sets checked by Run.
+	//
+	// Default: 100
+	Iterations int
+
+	// MaxSize is the maximum number of the elements to generate a set.
+	//
+	// Default: 16
+	MaxSize int
+
+	new func(elements ...T) S
+	gen func(*rand.Rand) T
+}
+
+// New returns a new tester with the set constructor and the element generator.
+func New[S Interface[S, T], PS Updater[S, T], T comparable](new func(elements ...T) S,
+	gen func(*rand.Rand) T) *Tester[S, PS, T] {
+	if new == nil {
+		panic("settest.New: the set constructor must not be nil")
+	}
+	if gen == nil {
+		panic("settest.New: the element generator must not be nil")
+	}
+	return &Tester[S, PS, T]{Seed: 1, Iterations: 100, MaxSize: 16, new: new, gen: gen}
+}
+
+// Run checks all the laws with Iterations groups of the random sets
+// generated from Seed.
+func (t *Tester[S, PS, T]) Run(tt *testing.T) {
+	tt.Helper()
+
+	iterations := t.Iterations
+	if iterations <= 0 {
+		iterations = 100
+	}
+
+	r := rand.New(rand.NewSource(t.Seed))
+	for i := 0; i < iterations; i++ {
+		if !t.Check(tt, r) {
+			tt.Logf("seed=%d, iteration=%d", t.Seed, i)
+			return
+		}
+	}
+}
+
+// Fuzz registers a fuzz target which checks all the laws with the random sets
+// generated from the fuzzed seed.
+func (t *Tester[S, PS, T]) Fuzz(f *testing.F) {
+	f.Helper()
+	f.Add(t.Seed)
+	f.Add(int64(0))
+	f.Fuzz(func(tt *testing.T, seed int64) {
+		if !t.Check(tt, rand.New(rand.NewSource(seed))) {
+			tt.Logf("seed=%d", seed)
+		}
+	})
+}
+
+// Check generates a group of the random sets by r, checks all the laws
+// with them, and reports whether all the laws hold.
+func (t *Tester[S, PS, T]) Check(tb testing.TB, r *rand.Rand) bool {
+	tb.Helper()
+	c := checker[S, PS, T]{tb: tb, new: t.new, ok: true}
+	c.check(t.random(r), t.random(r), t.random(r), t.gen(r))
+	return c.ok
+}
+
+func (t *Tester[S, PS, T]) random(r *rand.Rand) S {
+	max := t.MaxSize
+	if max <= 0 {
+		max = 16
+	}
+
+	elements := make([]T, r.Intn(max+1))
+	for i := range elements {
+		elements[i] = t.gen(r)
+	}
+	return t.new(elements...)
+}
+
+//////////////////////////////////////////////////////////////////////////////
+
+type model[T comparable] map[T]struct{}
+
+func (m model[T]) String() string {
+	elems := make([]string, 0, len(m))
+	for e := range m {
+		elems = append(elems, fmt.Sprint(e))
+	}
+	sort.Strings(elems)
+	return fmt.Sprint(elems)
+}
+
+type checker[S Interface[S, T], PS Updater[S, T], T comparable] struct {
+	tb  testing.TB
+	new func(elements ...T) S
+	ok  bool
+}
+
+func (c *checker[S, PS, T]) fail(format string, args ...any) {
+	c.tb.Helper()
+	c.tb.Errorf(format, args...)
+	c.ok = false
+}
+
+// model converts the set to the model and checks its consistency.
+func (c *checker[S, PS, T]) model(s S) model[T] {
+	c.tb.Helper()
+
+	elems := s.Slice()
+	m := make(model[T], len(elems))
+	for _, e := range elems {
+		if !s.Contains(e) {
+			c.fail("Contains(%v) returns false for the element in Slice", e)
+		}
+		m[e] = struct{}{}
+	}
+
+	if len(m) != len(elems) {
+		c.fail("Slice returns the duplicate elements: %v", elems)
+	}
+	if size := s.Size(); size != len(m) {
+		c.fail("Size returns %d, but Slice returns %d elements", size, len(m))
+	}
+
+	return m
+}
+
+// same reports whether the two sets have the same elements.
+func (c *checker[S, PS, T]) same(x, y S) bool {
+	mx, my := c.model(x), c.model(y)
+	if len(mx) != len(my) {
+		return false
+	}
+	for e := range mx {
+		if _, ok := my[e]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *checker[S, PS, T]) equal(law string, x, y S) {
+	c.tb.Helper()
+
+	if !c.same(x, y) {
+		c.fail("%s: %v != %v", law, c.model(x), c.model(y))
+	} else if !x.Equal(y) || !y.Equal(x) {
+		c.fail("%s: Equal returns false for the equal sets %v", law, c.model(x))
+	}
+}
+
+func (c *checker[S, PS, T]) unchanged(op string, s S, m model[T]) {
+	c.tb.Helper()
+
+	if n := c.model(s); n.String() != m.String() {
+		c.fail("%s modifies the input set from %v to %v", op, m, n)
+	}
+}
+
+func (c *checker[S, PS, T]) update(law string, s S, f func(PS), expect S) {
+	c.tb.Helper()
+
+	clone := s.Clone()
+	f(PS(&clone))
+	c.equal(law, clone, expect)
+}
+
+func (c *checker[S, PS, T]) check(a, b, d S, e T) {
+	c.tb.Helper()
+
+	ma, mb, md := c.model(a), c.model(b), c.model(d)
+	empty := c.new()
+
+	// Model: check the results against the element-wise definitions.
+	union, inter := a.Union(b), a.Intersection(b)
+	diff, sdiff := a.Difference(b), a.SymmetricDifference(b)
+	mu, mi, mdf, ms := c.model(union), c.model(inter), c.model(diff), c.model(sdiff)
+	for _, m := range []model[T]{ma, mb} {
+		for x := range m {
+			_, ina := ma[x]
+			_, inb := mb[x]
+			if _, ok := mu[x]; !ok {
+				c.fail("Union: %v is missing from %v ∪ %v", x, ma, mb)
+			}
+			if _, ok := mi[x]; ok != (ina && inb) {
+				c.fail("Intersection: wrong membership of %v in %v ∩ %v", x, ma, mb)
+			}
+			if _, ok := mdf[x]; ok != (ina && !inb) {
+				c.fail("Difference: wrong membership of %v in %v \\ %v", x, ma, mb)
+			}
+			if _, ok := ms[x]; ok != (ina != inb) {
+				c.fail("SymmetricDifference: wrong membership of %v in %v △ %v", x, ma, mb)
+			}
+		}
+	}
+	if len(mu) > len(ma)+len(mb) || len(mi) > len(ma) || len(mdf) > len(ma) || len(ms) > len(mu) {
+		c.fail("the results contain the unexpected elements: a=%v, b=%v", ma, mb)
+	}
+
+	// Purity: the pure operations must not modify the input sets.
+	_ = a.Union(b, d)
+	_ = a.Intersection(b, d)
+	_ = a.Difference(b, d)
+	_ = a.SymmetricDifference(b)
+	c.unchanged("pure operations", a, ma)
+	c.unchanged("pure operations", b, mb)
+	c.unchanged("pure operations", d, md)
+
+	// Commutativity
+	c.equal("Union is not commutative", a.Union(b), b.Union(a))
+	c.equal("Intersection is not commutative", a.Intersection(b), b.Intersection(a))
+	c.equal("SymmetricDifference is not commutative", a.SymmetricDifference(b), b.SymmetricDifference(a))
+
+	// Associativity
+	c.equal("Union is not associative", a.Union(b).Union(d), a.Union(b.Union(d)))
+	c.equal("Intersection is not associative", a.Intersection(b).Intersection(d), a.Intersection(b.Intersection(d)))
+	c.equal("SymmetricDifference is not associative",
+		a.SymmetricDifference(b).SymmetricDifference(d), a.SymmetricDifference(b.SymmetricDifference(d)))
+
+	// Variadic arguments
+	c.equal("Union(b, d) != Union(b).Union(d)", a.Union(b, d), a.Union(b).Union(d))
+	c.equal("Intersection(b, d) != Intersection(b).Intersection(d)", a.Intersection(b, d), a.Intersection(b).Intersection(d))
+	c.equal("Difference(b, d) != Difference(b).Difference(d)", a.Difference(b, d), a.Difference(b).Difference(d))
+	c.equal("Union() != Clone()", a.Union(), a)
+	if x := a.Intersection(); !c.same(x, a) && !c.same(x, empty) {
+		c.fail("Intersection() is neither Clone() nor empty: %v", c.model(x))
+	}
+	c.equal("Difference() != Clone()", a.Difference(), a)
+
+	// Idempotence and identity
+	c.equal("Union is not idempotent", a.Union(a), a)
+	c.equal("Intersection is not idempotent", a.Intersection(a), a)
+	c.equal("Difference with itself is not empty", a.Difference(a), empty)
+	c.equal("SymmetricDifference with itself is not empty", a.SymmetricDifference(a), empty)
+	c.equal("Union with the empty set is not identity", a.Union(empty), a)
+	c.equal("Intersection with the empty set is not empty", a.Intersection(empty), empty)
+
+	// Distributivity
+	c.equal("Intersection does not distribute over Union",
+		a.Intersection(b.Union(d)), a.Intersection(b).Union(a.Intersection(d)))
+	c.equal("Union does not distribute over Intersection",
+		a.Union(b.Intersection(d)), a.Union(b).Intersection(a.Union(d)))
+
+	// De Morgan, relative to the universe a ∪ b ∪ d.
+	u := a.Union(b, d)
+	c.equal("De Morgan: U\\(a∪b) != (U\\a)∩(U\\b)", u.Difference(a.Union(b)), u.Difference(a).Intersection(u.Difference(b)))
+	c.equal("De Morgan: U\\(a∩b) != (U\\a)∪(U\\b)", u.Difference(a.Intersection(b)), u.Difference(a).Union(u.Difference(b)))
+
+	// SymmetricDifference == (a\b) ∪ (b\a)
+	c.equal("SymmetricDifference != (a\\b)∪(b\\a)", a.SymmetricDifference(b), a.Difference(b).Union(b.Difference(a)))
+
+	// Update vs pure
+	c.update("UnionUpdate() != Union()", a, func(s PS) { s.UnionUpdate() }, a.Union())
+	c.update("UnionUpdate(b) != Union(b)", a, func(s PS) { s.UnionUpdate(b) }, a.Union(b))
+	c.update("UnionUpdate(b, d) != Union(b, d)", a, func(s PS) { s.UnionUpdate(b, d) }, a.Union(b, d))
+	c.update("IntersectionUpdate() != Intersection()", a, func(s PS) { s.IntersectionUpdate() }, a.Intersection())
+	c.update("IntersectionUpdate(b) != Intersection(b)", a, func(s PS) { s.IntersectionUpdate(b) }, a.Intersection(b))
+	c.update("IntersectionUpdate(b, d) != Intersection(b, d)", a, func(s PS) { s.IntersectionUpdate(b, d) }, a.Intersection(b, d))
+	c.update("DifferenceUpdate() != Difference()", a, func(s PS) { s.DifferenceUpdate() }, a.Difference())
+	c.update("DifferenceUpdate(b) != Difference(b)", a, func(s PS) { s.DifferenceUpdate(b) }, a.Difference(b))
+	c.update("DifferenceUpdate(b, d) != Difference(b, d)", a, func(s PS) { s.DifferenceUpdate(b, d) }, a.Difference(b, d))
+	c.update("SymmetricDifferenceUpdate(b) != SymmetricDifference(b)", a,
+		func(s PS) { s.SymmetricDifferenceUpdate(b) }, a.SymmetricDifference(b))
+	c.unchanged("update operations on the clone", a, ma)
+	c.unchanged("update operations", b, mb)
+	c.unchanged("update operations", d, md)
+
+	// Clone independence
+	clone := a.Clone()
+	c.equal("Clone is not equal to the original", clone, a)
+	clone.Add(e)
+	c.unchanged("adding an element into the clone", a, ma)
+	original := a.Clone()
+	a.Add(e)
+	c.unchanged("adding an element into the original", original, ma)
+	PS(&a).DifferenceUpdate(c.new(e))
+	if _, ok := ma[e]; !ok {
+		c.unchanged("removing the element from the original", a, ma)
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package settest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/xgfone/go-generics/set"
+)
+
+func TestSet(t *testing.T) {
+	New(set.New[int], Ints(32)).Run(t)
+
+	strs := New(set.New[string], func(r *rand.Rand) string { return string(rune('a' + r.Intn(26))) })
+	strs.Seed, strs.Iterations, strs.MaxSize = 2, 50, 32
+	strs.Run(t)
+}
+
+func FuzzSet(f *testing.F) {
+	New(set.New[int], Ints(16)).Fuzz(f)
+}
+
+// intersectionSet is a wrapper of set.Set with the configurable behaviors
+// of the intersection with no others.
+type intersectionSet struct {
+	set.Set[int]
+	emptyPure  bool // Intersection() returns the empty set.
+	keepUpdate bool // IntersectionUpdate() keeps all the elements.
+}
+
+func newIntersectionSet(emptyPure, keepUpdate bool) func(...int) intersectionSet {
+	return func(elements ...int) intersectionSet {
+		return intersectionSet{set.New(elements...), emptyPure, keepUpdate}
+	}
+}
+
+func (s intersectionSet) wrap(x set.Set[int]) intersectionSet {
+	return intersectionSet{x, s.emptyPure, s.keepUpdate}
+}
+
+func unwrap(others []intersectionSet) []set.Set[int] {
+	sets := make([]set.Set[int], len(others))
+	for i, s := range others {
+		sets[i] = s.Set
+	}
+	return sets
+}
+
+func (s intersectionSet) Equal(other intersectionSet) bool { return s.Set.Equal(other.Set) }
+func (s intersectionSet) Clone() intersectionSet           { return s.wrap(s.Set.Clone()) }
+func (s intersectionSet) Union(o ...intersectionSet) intersectionSet {
+	return s.wrap(s.Set.Union(unwrap(o)...))
+}
+func (s *intersectionSet) UnionUpdate(others ...intersectionSet) {
+	s.Set.UnionUpdate(unwrap(others)...)
+}
+func (s intersectionSet) Difference(o ...intersectionSet) intersectionSet {
+	return s.wrap(s.Set.Difference(unwrap(o)...))
+}
+func (s *intersectionSet) DifferenceUpdate(others ...intersectionSet) {
+	s.Set.DifferenceUpdate(unwrap(others)...)
+}
+func (s intersectionSet) Intersection(o ...intersectionSet) intersectionSet {
+	if len(o) == 0 && s.emptyPure {
+		return s.wrap(set.New[int]())
+	}
+	return s.wrap(s.Set.Intersection(unwrap(o)...))
+}
+func (s *intersectionSet) IntersectionUpdate(others ...intersectionSet) {
+	switch {
+	case len(others) > 0:
+		s.Set.IntersectionUpdate(unwrap(others)...)
+	case !s.keepUpdate:
+		s.Set.Clear()
+	}
+}
+func (s intersectionSet) SymmetricDifference(o intersectionSet) intersectionSet {
+	return s.wrap(s.Set.SymmetricDifference(o.Set))
+}
+func (s *intersectionSet) SymmetricDifferenceUpdate(other intersectionSet) {
+	s.Set.SymmetricDifferenceUpdate(other.Set)
+}
+
+type recorder struct {
+	testing.TB
+	errors int
+}
+
+func (r *recorder) Helper()                           {}
+func (r *recorder) Errorf(format string, args ...any) { r.errors++ }
+
+func TestIntersectionWithoutOthers(t *testing.T) {
+	check := func(emptyPure, keepUpdate bool) (failed bool) {
+		tester := New(newIntersectionSet(emptyPure, keepUpdate), Ints(8))
+		r := rand.New(rand.NewSource(1))
+		for i := 0; i < 20 && !failed; i++ {
+			rec := &recorder{TB: t}
+			if !tester.Check(rec, r) {
+				failed = rec.errors > 0
+			}
+		}
+		return
+	}
+
+	for _, c := range []struct {
+		name       string
+		emptyPure  bool
+		keepUpdate bool
+		bug        bool
+	}{
+		{"keep all like set.Set", false, true, false},
+		{"empty for both", true, false, false},
+		// The bug reported: IntersectionUpdate with zero others keeps everything,
+		// while Intersection with zero others returns the empty set.
+		{"IntersectionUpdate keeps everything", true, true, true},
+		{"IntersectionUpdate keeps nothing", false, false, true},
+	} {
+		if failed := check(c.emptyPure, c.keepUpdate); failed != c.bug {
+			t.Errorf("%s: expect bug=%v, but got %v", c.name, c.bug, failed)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package set
+
+// UnionFind is a disjoint-set structure, which uses the path compression
+// and the union by rank.
+//
+// The zero value is an empty UnionFind and ready to use.
+// But it is not thread-safe.
+type UnionFind[T comparable] struct {
+	index  map[T]int
+	elems  []T
+	parent []int
+	rank   []uint8
+	count  int
+}
+
+// NewUnionFind returns a new UnionFind, each element of which is
+// in the component of its own.
+func NewUnionFind[T comparable](elements ...T) *UnionFind[T] {
+	uf := &UnionFind[T]{
+		index:  make(map[T]int, len(elements)),
+		elems:  make([]T, 0, len(elements)),
+		parent: make([]int, 0, len(elements)),
+		rank:   make([]uint8, 0, len(elements)),
+	}
+	uf.Add(elements...)
+	return uf
+}
+
+// Add adds the elements as the new singleton components.
+//
+// The element that has been added will be ignored.
+func (uf *UnionFind[T]) Add(elements ...T) {
+	for _, e := range elements {
+		uf.add(e)
+	}
+}
+
+func (uf *UnionFind[T]) add(e T) int {
+	if i, ok := uf.index[e]; ok {
+		return i
+	}
+
+	if uf.index == nil {
+		uf.index = make(map[T]int)
+	}
+
+	i := len(uf.elems)
+	uf.index[e] = i
+	uf.elems = append(uf.elems, e)
+	uf.parent = append(uf.parent, i)
+	uf.rank = append(uf.rank, 0)
+	uf.count++
+	return i
+}
+
+func (uf *UnionFind[T]) root(i int) int {
+	r := i
+	for uf.parent[r] != r {
+		r = uf.parent[r]
+	}
+
+	// Path compression
+	for uf.parent[i] != r {
+		uf.parent[i], i = r, uf.parent[i]
+	}
+
+	return r
+}
+
+// Contains reports whether the element has been added.
+func (uf *UnionFind[T]) Contains(element T) bool {
+	_, ok := uf.index[element]
+	return ok
+}
+
+// Size returns the number of all the elements.
+func (uf *UnionFind[T]) Size() int {
+	return len(uf.elems)
+}
+
+// ComponentCount returns the number of the disjoint components.
+func (uf *UnionFind[T]) ComponentCount() int {
+	return uf.count
+}
+
+// Find returns the representative element of the component
+// that the element belongs to.
+//
+// If the element has not been added, return itself with ok==false.
+func (uf *UnionFind[T]) Find(element T) (root T, ok bool) {
+	i, ok := uf.index[element]
+	if !ok {
+		return element, false
+	}
+	return uf.elems[uf.root(i)], true
+}
+
+// Union merges the components that the two elements belong to,
+// and reports whether they were in the different components before.
+//
+// If an element has not been added, add it firstly.
+func (uf *UnionFind[T]) Union(x, y T) bool {
+	rx, ry := uf.root(uf.add(x)), uf.root(uf.add(y))
+	if rx == ry {
+		return false
+	}
+
+	switch {
+	case uf.rank[rx] < uf.rank[ry]:
+		uf.parent[rx] = ry
+	case uf.rank[rx] > uf.rank[ry]:
+		uf.parent[ry] = rx
+	default:
+		uf.parent[ry] = rx
+		uf.rank[rx]++
+	}
+
+	uf.count--
+	return true
+}
+
+// Connected reports whether the two elements are in the same component.
+//
+// An element that has not been added is only connected to itself.
+func (uf *UnionFind[T]) Connected(x, y T) bool {
+	if x == y {
+		return true
+	}
+
+	ix, ok := uf.index[x]
+	if !ok {
+		return false
+	}
+
+	iy, ok := uf.index[y]
+	if !ok {
+		return false
+	}
+
+	return uf.root(ix) == uf.root(iy)
+}
+
+// Groups returns all the disjoint components as the sets,
+// which are ordered by the first added element of each component.
+func (uf *UnionFind[T]) Groups() []Set[T] {
+	groups := make([]Set[T], 0, uf.count)
+	indexes := make(map[int]int, uf.count)
+	for i, e := range uf.elems {
+		r := uf.root(i)
+		g, ok := indexes[r]
+		if !ok {
+			g = len(groups)
+			indexes[r] = g
+			groups = append(groups, NewSet[T]())
+		}
+		groups[g].cache[e] = struct{}{}
+	}
+	return groups
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package set
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleUnionFind() {
+	uf := NewUnionFind(1, 2, 3, 4, 5)
+	uf.Union(1, 2)
+	uf.Union(3, 4)
+	uf.Union(2, 4)
+	uf.Union(6, 7)
+
+	fmt.Println(uf.Size(), uf.ComponentCount())
+	fmt.Println(uf.Connected(1, 3), uf.Connected(1, 5), uf.Connected(6, 7))
+	for _, group := range uf.Groups() {
+		fmt.Println(group.Size())
+	}
+
+	// Output:
+	// 7 3
+	// true false true
+	// 4
+	// 1
+	// 2
+}
+
+func TestUnionFind(t *testing.T) {
+	var uf UnionFind[string]
+	if root, ok := uf.Find("a"); ok || root != "a" {
+		t.Errorf("expect '%s' and false, but got '%s' and %v", "a", root, ok)
+	}
+	if !uf.Connected("a", "a") {
+		t.Errorf("expect an element to be connected to itself")
+	}
+
+	if !uf.Union("a", "b") {
+		t.Errorf("expect a union, but got not")
+	}
+	if uf.Union("b", "a") {
+		t.Errorf("unexpect a union for the same component")
+	}
+	uf.Add("c", "a")
+	if n := uf.ComponentCount(); n != 2 {
+		t.Errorf("expect %d components, but got %d", 2, n)
+	}
+
+	ra, _ := uf.Find("a")
+	rb, _ := uf.Find("b")
+	if ra != rb {
+		t.Errorf("expect the same root, but got '%s' and '%s'", ra, rb)
+	}
+	if uf.Connected("a", "c") {
+		t.Errorf("unexpect that '%s' and '%s' are connected", "a", "c")
+	}
+
+	groups := uf.Groups()
+	if len(groups) != 2 {
+		t.Fatalf("expect %d groups, but got %d", 2, len(groups))
+	}
+	if expect := NewSet("a", "b"); !groups[0].Equal(expect) {
+		t.Errorf("expect %v, but got %v", expect, groups[0])
+	}
+	if expect := NewSet("c"); !groups[1].Equal(expect) {
+		t.Errorf("expect %v, but got %v", expect, groups[1])
+	}
+}
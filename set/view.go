@@ -0,0 +1,237 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package set
+
+// View is a read-only view of a set, which is implemented by Set,
+// UnionView, IntersectionView and DifferenceView.
+type View[T comparable] interface {
+	Contains(element T) bool
+	Range(f func(element T))
+	Size() int
+}
+
+var (
+	_ View[int] = Set[int]{}
+	_ View[int] = UnionView[int]{}
+	_ View[int] = IntersectionView[int]{}
+	_ View[int] = DifferenceView[int]{}
+)
+
+func materialise[T comparable](v View[T]) Set[T] {
+	s := NewSet[T]()
+	v.Range(func(e T) { s.cache[e] = struct{}{} })
+	return s
+}
+
+func countView[T comparable](v View[T]) (n int) {
+	v.Range(func(T) { n++ })
+	return
+}
+
+//////////////////////////////////////////////////////////////////////////////
+
+// UnionView is a lazy view of the union of a set of views,
+// which does not allocate a new set.
+type UnionView[T comparable] struct {
+	views []View[T]
+}
+
+// NewUnionView returns a new lazy union view of the views.
+func NewUnionView[T comparable](views ...View[T]) UnionView[T] {
+	return UnionView[T]{views: views}
+}
+
+// UnionView returns a lazy view of the union of the set and all others.
+func (s Set[T]) UnionView(others ...Set[T]) UnionView[T] {
+	views := make([]View[T], 0, len(others)+1)
+	views = append(views, s)
+	for _, set := range others {
+		views = append(views, set)
+	}
+	return UnionView[T]{views: views}
+}
+
+// Contains returns true if the element is in any of the views.
+func (v UnionView[T]) Contains(element T) bool {
+	for _, view := range v.views {
+		if view.Contains(element) {
+			return true
+		}
+	}
+	return false
+}
+
+// Range travels all the elements of the view, each of which is only visited once.
+func (v UnionView[T]) Range(f func(element T)) {
+	for i, view := range v.views {
+		prevs := v.views[:i]
+		view.Range(func(e T) {
+			for _, prev := range prevs {
+				if prev.Contains(e) {
+					return
+				}
+			}
+			f(e)
+		})
+	}
+}
+
+// Size computes and returns the number of the elements in the view.
+func (v UnionView[T]) Size() int {
+	switch len(v.views) {
+	case 0:
+		return 0
+	case 1:
+		return v.views[0].Size()
+	default:
+		return countView[T](v)
+	}
+}
+
+// Materialise converts the view to a new concrete set.
+func (v UnionView[T]) Materialise() Set[T] { return materialise[T](v) }
+
+//////////////////////////////////////////////////////////////////////////////
+
+// IntersectionView is a lazy view of the elements common to the base view
+// and all others, which does not allocate a new set.
+type IntersectionView[T comparable] struct {
+	base   View[T]
+	others []View[T]
+}
+
+// NewIntersectionView returns a new lazy view of the intersection
+// of the base view and all others.
+func NewIntersectionView[T comparable](base View[T], others ...View[T]) IntersectionView[T] {
+	return IntersectionView[T]{base: base, others: others}
+}
+
+// IntersectionView returns a lazy view of the intersection of the set and all others.
+func (s Set[T]) IntersectionView(others ...Set[T]) IntersectionView[T] {
+	views := make([]View[T], len(others))
+	for i, set := range others {
+		views[i] = set
+	}
+	return IntersectionView[T]{base: s, others: views}
+}
+
+func (v IntersectionView[T]) inOthers(element T) bool {
+	for _, view := range v.others {
+		if !view.Contains(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// Contains returns true if the element is in the base view and all others.
+func (v IntersectionView[T]) Contains(element T) bool {
+	return v.base != nil && v.base.Contains(element) && v.inOthers(element)
+}
+
+// Range travels all the elements of the view.
+func (v IntersectionView[T]) Range(f func(element T)) {
+	if v.base == nil {
+		return
+	}
+
+	v.base.Range(func(e T) {
+		if v.inOthers(e) {
+			f(e)
+		}
+	})
+}
+
+// Size computes and returns the number of the elements in the view.
+func (v IntersectionView[T]) Size() int {
+	switch {
+	case v.base == nil:
+		return 0
+	case len(v.others) == 0:
+		return v.base.Size()
+	default:
+		return countView[T](v)
+	}
+}
+
+// Materialise converts the view to a new concrete set.
+func (v IntersectionView[T]) Materialise() Set[T] { return materialise[T](v) }
+
+//////////////////////////////////////////////////////////////////////////////
+
+// DifferenceView is a lazy view of the elements in the base view
+// that are not in the others, which does not allocate a new set.
+type DifferenceView[T comparable] struct {
+	base   View[T]
+	others []View[T]
+}
+
+// NewDifferenceView returns a new lazy view of the difference
+// between the base view and all others.
+func NewDifferenceView[T comparable](base View[T], others ...View[T]) DifferenceView[T] {
+	return DifferenceView[T]{base: base, others: others}
+}
+
+// DifferenceView returns a lazy view of the elements in the set
+// that are not in the others.
+func (s Set[T]) DifferenceView(others ...Set[T]) DifferenceView[T] {
+	views := make([]View[T], len(others))
+	for i, set := range others {
+		views[i] = set
+	}
+	return DifferenceView[T]{base: s, others: views}
+}
+
+func (v DifferenceView[T]) inOthers(element T) bool {
+	for _, view := range v.others {
+		if view.Contains(element) {
+			return true
+		}
+	}
+	return false
+}
+
+// Contains returns true if the element is in the base view but not in any others.
+func (v DifferenceView[T]) Contains(element T) bool {
+	return v.base != nil && v.base.Contains(element) && !v.inOthers(element)
+}
+
+// Range travels all the elements of the view.
+func (v DifferenceView[T]) Range(f func(element T)) {
+	if v.base == nil {
+		return
+	}
+
+	v.base.Range(func(e T) {
+		if !v.inOthers(e) {
+			f(e)
+		}
+	})
+}
+
+// Size computes and returns the number of the elements in the view.
+func (v DifferenceView[T]) Size() int {
+	switch {
+	case v.base == nil:
+		return 0
+	case len(v.others) == 0:
+		return v.base.Size()
+	default:
+		return countView[T](v)
+	}
+}
+
+// Materialise converts the view to a new concrete set.
+func (v DifferenceView[T]) Materialise() Set[T] { return materialise[T](v) }
@@ -0,0 +1,82 @@
+// Copyright 2026 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package set
+
+import (
+	"slices"
+	"testing"
+)
+
+func viewSlice[T comparable](v View[T]) []T {
+	var s []T
+	v.Range(func(e T) { s = append(s, e) })
+	return s
+}
+
+func TestViews(t *testing.T) {
+	s1 := NewSet(1, 2, 3, 4)
+	s2 := NewSet(3, 4, 5)
+	s3 := NewSet(4, 5, 6)
+
+	union := s1.UnionView(s2, s3)
+	if n := union.Size(); n != 6 {
+		t.Errorf("expect size %d, but got %d", 6, n)
+	}
+	if !union.Contains(6) || union.Contains(7) {
+		t.Errorf("union view contains unexpected elements")
+	}
+	if vs := viewSlice[int](union); len(vs) != 6 {
+		t.Errorf("expect %d elements, but got %v", 6, vs)
+	}
+	if m := union.Materialise(); !m.Equal(s1.Union(s2, s3)) {
+		t.Errorf("expect %v, but got %v", s1.Union(s2, s3), m)
+	}
+
+	inter := s1.IntersectionView(s2, s3)
+	if vs := viewSlice[int](inter); !slices.Equal(vs, []int{4}) {
+		t.Errorf("expect %v, but got %v", []int{4}, vs)
+	}
+	if n := inter.Size(); n != 1 {
+		t.Errorf("expect size %d, but got %d", 1, n)
+	}
+	if !inter.Contains(4) || inter.Contains(3) {
+		t.Errorf("intersection view contains unexpected elements")
+	}
+
+	diff := s1.DifferenceView(s2, s3)
+	if m := diff.Materialise(); !m.Equal(NewSet(1, 2)) {
+		t.Errorf("expect %v, but got %v", NewSet(1, 2), m)
+	}
+	if !diff.Contains(1) || diff.Contains(4) || diff.Contains(7) {
+		t.Errorf("difference view contains unexpected elements")
+	}
+
+	// Compose the views.
+	composed := NewDifferenceView[int](union, inter, NewSet(6))
+	if m := composed.Materialise(); !m.Equal(NewSet(1, 2, 3, 5)) {
+		t.Errorf("expect %v, but got %v", NewSet(1, 2, 3, 5), m)
+	}
+
+	// The views reflect the changes of the underlying sets.
+	s3.Add(7)
+	if !union.Contains(7) {
+		t.Errorf("expect the union view to contain the new element")
+	}
+
+	var empty IntersectionView[int]
+	if empty.Size() != 0 || empty.Contains(1) {
+		t.Errorf("expect an empty view")
+	}
+}